@@ -393,14 +393,20 @@ resource "incapsula_waf_security_rule" "example-waf-backdoor-rule" {
 resource "incapsula_security_rule_exception" "example-waf-backdoor-rule-exception" {
   site_id      = incapsula_site.example-site.id
   rule_id      = "api.threats.backdoor"
-  client_apps  = "488,123"
-  countries    = "JM,US"
-  continents   = "NA,AF"
-  ips          = "1.2.3.6,1.2.3.7"
-  url_patterns = "EQUALS,CONTAINS"
-  urls         = "/myurl,/myurl2"
-  user_agents  = "myUserAgent"
-  parameters   = "myparam"
+  client_apps  = ["488", "123"]
+  countries    = ["JM", "US"]
+  continents   = ["NA", "AF"]
+  ips          = ["1.2.3.6", "1.2.3.7"]
+  user_agents  = ["myUserAgent"]
+  parameters   = ["myparam"]
+  urls {
+    url     = "/myurl"
+    pattern = "EQUALS"
+  }
+  urls {
+    url     = "/myurl2"
+    pattern = "CONTAINS"
+  }
 }
 
 # api.acl.bot_access_control Security Rule (one instance per site)
@@ -415,11 +421,17 @@ resource "incapsula_waf_security_rule" "example-waf-bot-access-control-rule" {
 resource "incapsula_security_rule_exception" "example-waf-bot_access-control-rule-exception" {
   site_id          = incapsula_site.example-site.id
   rule_id          = "api.threats.bot_access_control"
-  client_app_types = "DataScraper,"
-  ips              = "1.2.3.6,1.2.3.7"
-  url_patterns     = "EQUALS,CONTAINS"
-  urls             = "/myurl,/myurl2"
-  user_agents      = "myUserAgent"
+  client_app_types = ["DataScraper"]
+  ips              = ["1.2.3.6", "1.2.3.7"]
+  user_agents      = ["myUserAgent"]
+  urls {
+    url     = "/myurl"
+    pattern = "EQUALS"
+  }
+  urls {
+    url     = "/myurl2"
+    pattern = "CONTAINS"
+  }
 }
 
 # api.threats.cross_site_scripting Security Rule (one instance per site)
@@ -433,12 +445,18 @@ resource "incapsula_waf_security_rule" "example-waf-cross-site-scripting-rule" {
 resource "incapsula_security_rule_exception" "example-waf-cross-site-scripting-rule-exception" {
   site_id      = incapsula_site.example-site.id
   rule_id      = "api.threats.cross_site_scripting"
-  client_apps  = "488,123"
-  countries    = "JM,US"
-  continents   = "NA,AF"
-  url_patterns = "EQUALS,CONTAINS"
-  urls         = "/myurl,/myurl2"
-  parameters   = "myparam"
+  client_apps  = ["488", "123"]
+  countries    = ["JM", "US"]
+  continents   = ["NA", "AF"]
+  parameters   = ["myparam"]
+  urls {
+    url     = "/myurl"
+    pattern = "EQUALS"
+  }
+  urls {
+    url     = "/myurl2"
+    pattern = "CONTAINS"
+  }
 }
 
 # api.acl.ddos Security Rule (one instance per site)
@@ -453,12 +471,18 @@ resource "incapsula_waf_security_rule" "example-waf-ddos-rule" {
 resource "incapsula_security_rule_exception" "example-waf-ddos-rule-exception" {
   site_id      = incapsula_site.example-site.id
   rule_id      = "api.threats.ddos"
-  client_apps  = "488,123"
-  countries    = "JM,US"
-  continents   = "NA,AF"
-  ips          = "1.2.3.6,1.2.3.7"
-  url_patterns = "EQUALS,CONTAINS"
-  urls         = "/myurl,/myurl2"
+  client_apps  = ["488", "123"]
+  countries    = ["JM", "US"]
+  continents   = ["NA", "AF"]
+  ips          = ["1.2.3.6", "1.2.3.7"]
+  urls {
+    url     = "/myurl"
+    pattern = "EQUALS"
+  }
+  urls {
+    url     = "/myurl2"
+    pattern = "CONTAINS"
+  }
 }
 
 # api.acl.illegal_resource_access Security Rule (one instance per site)
@@ -472,13 +496,19 @@ resource "incapsula_waf_security_rule" "example-waf-illegal-resource-rule" {
 resource "incapsula_security_rule_exception" "example-waf-illegal-resource-access-rule-exception" {
   site_id      = incapsula_site.example-site.id
   rule_id      = "api.threats.illegal_resource_access"
-  client_apps  = "488,123"
-  countries    = "JM,US"
-  continents   = "NA,AF"
-  ips          = "1.2.3.6,1.2.3.7"
-  url_patterns = "EQUALS,CONTAINS"
-  urls         = "/myurl,/myurl2"
-  parameters   = "myparam"
+  client_apps  = ["488", "123"]
+  countries    = ["JM", "US"]
+  continents   = ["NA", "AF"]
+  ips          = ["1.2.3.6", "1.2.3.7"]
+  parameters   = ["myparam"]
+  urls {
+    url     = "/myurl"
+    pattern = "EQUALS"
+  }
+  urls {
+    url     = "/myurl2"
+    pattern = "CONTAINS"
+  }
 }
 
 # api.acl.remote_file_inclusion Security Rule (one instance per site)
@@ -492,14 +522,20 @@ resource "incapsula_waf_security_rule" "example-waf-remote-file-inclusion-rule"
 resource "incapsula_security_rule_exception" "example-waf-remote-file-inclusion-rule-exception" {
   site_id      = incapsula_site.example-site.id
   rule_id      = "api.threats.remote_file_inclusion"
-  client_apps  = "488,123"
-  countries    = "JM,US"
-  continents   = "NA,AF"
-  ips          = "1.2.3.6,1.2.3.7"
-  url_patterns = "EQUALS,CONTAINS"
-  urls         = "/myurl,/myurl2"
-  user_agents  = "myUserAgent"
-  parameters   = "myparam"
+  client_apps  = ["488", "123"]
+  countries    = ["JM", "US"]
+  continents   = ["NA", "AF"]
+  ips          = ["1.2.3.6", "1.2.3.7"]
+  user_agents  = ["myUserAgent"]
+  parameters   = ["myparam"]
+  urls {
+    url     = "/myurl"
+    pattern = "EQUALS"
+  }
+  urls {
+    url     = "/myurl2"
+    pattern = "CONTAINS"
+  }
 }
 
 # api.acl.sql_injection Security Rule (one instance per site)
@@ -513,12 +549,18 @@ resource "incapsula_waf_security_rule" "example-waf-sql-injection-rule" {
 resource "incapsula_security_rule_exception" "example-waf-sql-injection-rule-exception" {
   site_id      = incapsula_site.example-site.id
   rule_id      = "api.threats.sql_injection"
-  client_apps  = "488,123"
-  countries    = "JM,US"
-  continents   = "NA,AF"
-  ips          = "1.2.3.6,1.2.3.7"
-  url_patterns = "EQUALS,CONTAINS"
-  urls         = "/myurl,/myurl2"
+  client_apps  = ["488", "123"]
+  countries    = ["JM", "US"]
+  continents   = ["NA", "AF"]
+  ips          = ["1.2.3.6", "1.2.3.7"]
+  urls {
+    url     = "/myurl"
+    pattern = "EQUALS"
+  }
+  urls {
+    url     = "/myurl2"
+    pattern = "CONTAINS"
+  }
 }
 
 ###################################################################
@@ -85,43 +85,55 @@ resource "incapsula_waf_security_rule" "example-waf-ddos-rule" {
 ####################################################################
 
 # Security Rule: Country
-resource "incapsula_acl_security_rule" "example-global-blacklist-country-rule" {
+resource "incapsula_site_acl" "example-global-blacklist-country-rule" {
   site_id   = incapsula_site.example-site.id
   rule_id   = "api.acl.blacklisted_countries"
-  countries = "AI,AN"
+  countries = ["AI", "AN"]
 }
 
 # Security Rule: Blacklist IP
-resource "incapsula_acl_security_rule" "example-global-blacklist-ip-rule" {
+resource "incapsula_site_acl" "example-global-blacklist-ip-rule" {
   site_id = incapsula_site.example-site.id
   rule_id = "api.acl.blacklisted_ips"
-  ips     = "192.168.1.1,192.168.1.2"
+  ips     = ["192.168.1.1", "192.168.1.2"]
 }
 
 # Security Rule: Blacklist IP Exception
-resource "incapsula_acl_security_rule" "example-global-blacklist-ip-rule_exception" {
-  rule_id      = "api.acl.blacklisted_ips"
-  site_id      = incapsula_site.example-site.id
-  ips          = "192.168.1.1,192.168.1.2"
-  urls         = "/myurl,/myurl2"
-  url_patterns = "EQUALS,CONTAINS"
-  countries    = "JM,US"
-  client_apps  = "488,123"
+resource "incapsula_security_rule_exception" "example-global-blacklist-ip-rule_exception" {
+  rule_id     = "api.acl.blacklisted_ips"
+  site_id     = incapsula_site.example-site.id
+  ips         = ["192.168.1.1", "192.168.1.2"]
+  countries   = ["JM", "US"]
+  client_apps = ["488", "123"]
+  urls {
+    url     = "/myurl"
+    pattern = "EQUALS"
+  }
+  urls {
+    url     = "/myurl2"
+    pattern = "CONTAINS"
+  }
 }
 
 # Security Rule: URL
-resource "incapsula_acl_security_rule" "example-global-blacklist-url-rule" {
-  rule_id      = "api.acl.blacklisted_urls"
-  site_id      = incapsula_site.example-site.id
-  url_patterns = "CONTAINS,EQUALS"
-  urls         = "/alpha,/bravo"
+resource "incapsula_site_acl" "example-global-blacklist-url-rule" {
+  rule_id = "api.acl.blacklisted_urls"
+  site_id = incapsula_site.example-site.id
+  urls {
+    url     = "/alpha"
+    pattern = "CONTAINS"
+  }
+  urls {
+    url     = "/bravo"
+    pattern = "EQUALS"
+  }
 }
 
 # Security Rule: Whitelist IP
-resource "incapsula_acl_security_rule" "example-global-whitelist-ip-rule" {
+resource "incapsula_site_acl" "example-global-whitelist-ip-rule" {
   rule_id = "api.acl.whitelisted_ips"
   site_id = incapsula_site.example-site.id
-  ips     = "192.168.1.3,192.168.1.4"
+  ips     = ["192.168.1.3", "192.168.1.4"]
 }
 
 ####################################################################
@@ -240,12 +252,10 @@ resource "incapsula_incap_rule" "example-incap-rule-rewrite-header" {
 
 # Incap Rule: Rewrite URL (ADR)
 resource "incapsula_incap_rule" "example-incap-rule-rewrite-url" {
-  name         = "ExampleRewriteURL"
-  site_id      = incapsula_site.example-site.id
-  action       = "RULE_ACTION_REWRITE_URL"
-  filter       = "Full-URL == \"/someurl\""
-  add_missing  = "true"
-  from         = "*"
-  to           = "/redirect"
-  rewrite_name = "my_test_header"
+  name    = "ExampleRewriteURL"
+  site_id = incapsula_site.example-site.id
+  action  = "RULE_ACTION_REWRITE_URL"
+  filter  = "Full-URL == \"/someurl\""
+  from    = "*"
+  to      = "/redirect"
 }
@@ -2,4 +2,8 @@ module github.com/terraform-providers/terraform-provider-incapsula
 
 go 1.16
 
-require github.com/hashicorp/terraform-plugin-sdk/v2 v2.10.1
+require (
+	github.com/hashicorp/go-cty v1.4.1-0.20200414143053-d3edf31b6320
+	github.com/hashicorp/terraform-plugin-sdk/v2 v2.10.1
+	gopkg.in/yaml.v2 v2.4.0
+)
@@ -1,5 +1,12 @@
 package incapsula
 
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
 type ViolationActions struct {
 	InvalidUrlViolationAction        string `json:"invalidUrlViolationAction"`
 	InvalidMethodViolationAction     string `json:"invalidMethodViolationAction"`
@@ -21,3 +28,316 @@ type EndpointResponse struct {
 	ViolationActions             UserViolationActions `json:"violationActions"`
 	SpecificationViolationAction string               `json:"specificationViolationAction"`
 }
+
+// Violation action inherited from the parent object (endpoint -> API -> site)
+const apiSecurityDefaultViolationAction = "DEFAULT"
+
+var apiSecurityViolationActions = []string{"ALERT_ONLY", "BLOCK_REQUEST", "BLOCK_USER", "BLOCK_IP", "IGNORE"}
+
+// Violation action fields of the site and API configurations, the endpoint configuration only has the last three
+var apiSecurityViolationActionFields = []string{"invalid_url_violation_action", "invalid_method_violation_action", "missing_param_violation_action", "invalid_param_name_violation_action", "invalid_param_value_violation_action"}
+var apiSecurityEndpointViolationActionFields = apiSecurityViolationActionFields[2:]
+
+var apiSecurityViolationActionDescriptions = map[string]string{
+	"invalid_url_violation_action":         "The action taken when an invalid URL Violation occurs.",
+	"invalid_method_violation_action":      "The action taken when an invalid method Violation occurs.",
+	"missing_param_violation_action":       "The action taken when a missing parameter Violation occurs.",
+	"invalid_param_name_violation_action":  "The action taken when an invalid parameter name Violation occurs.",
+	"invalid_param_value_violation_action": "The action taken when an invalid parameter value Violation occurs.",
+}
+
+// apiSecurityViolationActionsGetter is implemented by both schema.ResourceData and schema.ResourceDiff
+type apiSecurityViolationActionsGetter interface {
+	Get(key string) interface{}
+}
+
+// apiSecurityViolationActionsSchema is the violation_actions block shared by the site, API and endpoint configurations.
+// DEFAULT is only allowed, and used as default, when the actions are inherited from a parent object.
+func apiSecurityViolationActionsSchema(fields []string, inherited bool) *schema.Schema {
+	actions := apiSecurityViolationActions
+	defaultAction := "ALERT_ONLY"
+	if inherited {
+		actions = append([]string{}, apiSecurityViolationActions...)
+		actions = append(actions, apiSecurityDefaultViolationAction)
+		defaultAction = apiSecurityDefaultViolationAction
+	}
+
+	blockSchema := map[string]*schema.Schema{}
+	for _, field := range fields {
+		blockSchema[field] = &schema.Schema{
+			Description:  apiSecurityViolationActionDescriptions[field],
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      defaultAction,
+			ValidateFunc: validation.StringInSlice(actions, false),
+		}
+	}
+
+	return &schema.Schema{
+		Description:   "The violation actions. Replaces the *_violation_action arguments.",
+		Type:          schema.TypeList,
+		Optional:      true,
+		MaxItems:      1,
+		ConflictsWith: fields,
+		Elem:          &schema.Resource{Schema: blockSchema},
+	}
+}
+
+// apiSecurityEffectiveViolationActionsSchema adds the computed effective_* attributes of the fields
+func apiSecurityEffectiveViolationActionsSchema(resourceSchema map[string]*schema.Schema, fields []string) {
+	for _, field := range fields {
+		resourceSchema["effective_"+field] = &schema.Schema{
+			Description: apiSecurityViolationActionDescriptions[field] + " DEFAULT is resolved against the parent objects.",
+			Type:        schema.TypeString,
+			Computed:    true,
+		}
+	}
+}
+
+// getApiSecurityViolationAction returns the action of the violation_actions block, or of the deprecated argument
+func getApiSecurityViolationAction(d apiSecurityViolationActionsGetter, field string, defaultAction string) string {
+	if block, ok := d.Get("violation_actions").([]interface{}); ok && len(block) > 0 && block[0] != nil {
+		if action, _ := block[0].(map[string]interface{})[field].(string); action != "" {
+			return action
+		}
+	}
+	if action, _ := d.Get(field).(string); action != "" {
+		return action
+	}
+
+	return defaultAction
+}
+
+// setApiSecurityViolationActions sets the deprecated arguments, and the violation_actions block when it is used
+func setApiSecurityViolationActions(d *schema.ResourceData, actions map[string]string) {
+	for field, action := range actions {
+		d.Set(field, action)
+	}
+
+	if block, ok := d.Get("violation_actions").([]interface{}); ok && len(block) > 0 {
+		blockActions := make(map[string]interface{}, len(actions))
+		for field, action := range actions {
+			blockActions[field] = action
+		}
+		d.Set("violation_actions", []interface{}{blockActions})
+	}
+}
+
+// resolveApiSecurityViolationAction returns the first action which is not inherited, from the object to its parents
+func resolveApiSecurityViolationAction(actions ...string) string {
+	for _, action := range actions {
+		if action != "" && action != apiSecurityDefaultViolationAction {
+			return action
+		}
+	}
+
+	return apiSecurityDefaultViolationAction
+}
+
+func (v *ViolationActions) get(field string) string {
+	switch field {
+	case "invalid_url_violation_action":
+		return v.InvalidUrlViolationAction
+	case "invalid_method_violation_action":
+		return v.InvalidMethodViolationAction
+	case "missing_param_violation_action":
+		return v.MissingParamViolationAction
+	case "invalid_param_name_violation_action":
+		return v.InvalidParamNameViolationAction
+	case "invalid_param_value_violation_action":
+		return v.InvalidParamValueViolationAction
+	}
+	return ""
+}
+
+func (v *ViolationActions) toMap() map[string]string {
+	actions := make(map[string]string, len(apiSecurityViolationActionFields))
+	for _, field := range apiSecurityViolationActionFields {
+		actions[field] = v.get(field)
+	}
+	return actions
+}
+
+func (v *UserViolationActions) toMap() map[string]string {
+	return map[string]string{
+		"missing_param_violation_action":       v.MissingParamViolationAction,
+		"invalid_param_name_violation_action":  v.InvalidParamNameViolationAction,
+		"invalid_param_value_violation_action": v.InvalidParamValueViolationAction,
+	}
+}
+
+// getApiSecurityParentViolationActions returns the violation actions of the parent objects, nearest first:
+// the API configuration (when apiID is not 0) and the site configuration
+func getApiSecurityParentViolationActions(client *Client, siteID, apiID int) ([]ViolationActions, error) {
+	parents := []ViolationActions{}
+
+	if apiID != 0 {
+		apiConfigGetResponse, err := client.GetApiSecurityApiConfig(siteID, apiID)
+		if err != nil {
+			return nil, err
+		}
+		parents = append(parents, apiConfigGetResponse.Value.ViolationActions)
+	}
+
+	siteConfigGetResponse, err := client.ReadApiSecuritySiteConfig(siteID)
+	if err != nil {
+		return nil, err
+	}
+	parents = append(parents, siteConfigGetResponse.Value.ViolationActions)
+
+	return parents, nil
+}
+
+// resolveApiSecurityEffectiveViolationActions resolves DEFAULT actions against the parent objects
+func resolveApiSecurityEffectiveViolationActions(fields []string, actions map[string]string, parents []ViolationActions) map[string]string {
+	effectiveActions := make(map[string]string, len(fields))
+	for _, field := range fields {
+		inheritance := []string{actions[field]}
+		for i := range parents {
+			inheritance = append(inheritance, parents[i].get(field))
+		}
+		effectiveActions[field] = resolveApiSecurityViolationAction(inheritance...)
+	}
+
+	return effectiveActions
+}
+
+// setApiSecurityEffectiveViolationActionsDiff plans the effective violation actions. The parent configurations may change
+// in the same apply, so an inherited action is only known after the apply of a new or changed resource,
+// and keeps its current value otherwise.
+func setApiSecurityEffectiveViolationActionsDiff(d *schema.ResourceDiff, fields []string, actions map[string]string) error {
+	changed := d.Id() == "" || len(d.GetChangedKeysPrefix("")) > 0
+	for _, field := range fields {
+		action := actions[field]
+		if action != "" && action != apiSecurityDefaultViolationAction {
+			if err := d.SetNew("effective_"+field, action); err != nil {
+				return err
+			}
+		} else if changed {
+			if err := d.SetNewComputed("effective_" + field); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// apiSecurityEndpointsSchema returns the schema of a list of endpoints with their violation actions
+func apiSecurityEndpointsSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Description: description,
+		Type:        schema.TypeList,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"id": {
+					Description: "The endpoint ID.",
+					Type:        schema.TypeInt,
+					Computed:    true,
+				},
+				"key": {
+					Description: "The \"METHOD path\" key of the endpoint.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"method": {
+					Description: "The HTTP method of the endpoint.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"path": {
+					Description: "The URL path of the endpoint.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"missing_param_violation_action": {
+					Description: "The action taken when a missing parameter Violation occurs.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"invalid_param_name_violation_action": {
+					Description: "The action taken when an invalid parameter name Violation occurs.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"invalid_param_value_violation_action": {
+					Description: "The action taken when an invalid parameter value Violation occurs.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+				"specification_violation_action": {
+					Description: "The action taken when a specification Violation occurs.",
+					Type:        schema.TypeString,
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func flattenApiSecurityEndpoint(endpoint EndpointResponse, key string) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                                   endpoint.Id,
+		"key":                                  key,
+		"method":                               endpoint.Method,
+		"path":                                 endpoint.Path,
+		"missing_param_violation_action":       endpoint.ViolationActions.MissingParamViolationAction,
+		"invalid_param_name_violation_action":  endpoint.ViolationActions.InvalidParamNameViolationAction,
+		"invalid_param_value_violation_action": endpoint.ViolationActions.InvalidParamValueViolationAction,
+		"specification_violation_action":       endpoint.SpecificationViolationAction,
+	}
+}
+
+// apiSecurityEndpointKeys resolves the keys of the endpoints of an API as in its specification, the endpoint paths
+// returned by the API may be prefixed by the base path
+type apiSecurityEndpointKeys struct {
+	basePath          string
+	specificationKeys []string
+}
+
+// key returns the key of an endpoint, without base path and specification the key of the API path
+func (k *apiSecurityEndpointKeys) key(method, path string) string {
+	if k == nil {
+		return apiSecurityEndpointKey(method, path)
+	}
+	return getApiSpecificationEndpointKey(method, path, k.basePath, k.specificationKeys)
+}
+
+// path returns the path of the key of an endpoint, e.g. /users for /v1/users with the /v1 base path
+func (k *apiSecurityEndpointKeys) path(method, path string) string {
+	return strings.TrimPrefix(k.key(method, path), apiSecurityEndpointKey(method, ""))
+}
+
+// getApiSecurityEndpointKeys gets the base path and the specification of an API
+func getApiSecurityEndpointKeys(client *Client, siteID, apiID int) (*apiSecurityEndpointKeys, error) {
+	apiConfigGetResponse, err := client.GetApiSecurityApiConfig(siteID, apiID)
+	if err != nil {
+		return nil, err
+	}
+
+	apiConfigGetFileResponse, err := client.GetApiSecurityApiSwaggerConfig(siteID, apiID)
+	if err != nil {
+		return nil, err
+	}
+	spec, err := parseApiSpecification(apiConfigGetFileResponse.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiSecurityEndpointKeys{
+		basePath:          apiConfigGetResponse.Value.BasePath,
+		specificationKeys: getApiSpecificationEndpointKeys(spec),
+	}, nil
+}
+
+// findApiSecurityEndpoint returns the endpoint of a "METHOD path" key
+func findApiSecurityEndpoint(endpoints []EndpointResponse, key string, keys *apiSecurityEndpointKeys) (EndpointResponse, bool) {
+	for _, endpoint := range endpoints {
+		if keys.key(endpoint.Method, endpoint.Path) == key {
+			return endpoint, true
+		}
+	}
+
+	return EndpointResponse{}, false
+}
@@ -0,0 +1,108 @@
+package incapsula
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestResolveApiSecurityViolationAction(t *testing.T) {
+	cases := []struct {
+		actions  []string
+		expected string
+	}{
+		{[]string{"BLOCK_IP", "ALERT_ONLY", "IGNORE"}, "BLOCK_IP"},
+		{[]string{"DEFAULT", "BLOCK_USER", "IGNORE"}, "BLOCK_USER"},
+		{[]string{"DEFAULT", "DEFAULT", "BLOCK_REQUEST"}, "BLOCK_REQUEST"},
+		{[]string{"", "DEFAULT", "ALERT_ONLY"}, "ALERT_ONLY"},
+		{[]string{"DEFAULT", "DEFAULT"}, "DEFAULT"},
+		{[]string{}, "DEFAULT"},
+	}
+
+	for _, c := range cases {
+		if actual := resolveApiSecurityViolationAction(c.actions...); actual != c.expected {
+			t.Errorf("resolveApiSecurityViolationAction(%v): expected %s, got %s", c.actions, c.expected, actual)
+		}
+	}
+}
+
+func TestResolveApiSecurityEffectiveViolationActions(t *testing.T) {
+	parents := []ViolationActions{
+		{MissingParamViolationAction: "DEFAULT", InvalidParamNameViolationAction: "BLOCK_IP", InvalidParamValueViolationAction: "DEFAULT"},
+		{MissingParamViolationAction: "BLOCK_USER", InvalidParamNameViolationAction: "IGNORE", InvalidParamValueViolationAction: "ALERT_ONLY"},
+	}
+	actions := map[string]string{
+		"missing_param_violation_action":       "DEFAULT",
+		"invalid_param_name_violation_action":  "DEFAULT",
+		"invalid_param_value_violation_action": "BLOCK_REQUEST",
+	}
+
+	effectiveActions := resolveApiSecurityEffectiveViolationActions(apiSecurityEndpointViolationActionFields, actions, parents)
+	expected := map[string]string{
+		"missing_param_violation_action":       "BLOCK_USER",
+		"invalid_param_name_violation_action":  "BLOCK_IP",
+		"invalid_param_value_violation_action": "BLOCK_REQUEST",
+	}
+	for field, action := range expected {
+		if effectiveActions[field] != action {
+			t.Errorf("Expected effective %s to be %s, got %s", field, action, effectiveActions[field])
+		}
+	}
+}
+
+func TestGetApiSecurityViolationAction(t *testing.T) {
+	resourceSchema := resourceApiSecurityEndpointConfig().Schema
+
+	// Block takes precedence, its unset fields default to DEFAULT
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"api_id": 1,
+		"method": "GET",
+		"path":   "/users",
+		"violation_actions": []interface{}{
+			map[string]interface{}{"missing_param_violation_action": "BLOCK_IP"},
+		},
+	})
+	if action := getApiSecurityViolationAction(d, "missing_param_violation_action", "ALERT_ONLY"); action != "BLOCK_IP" {
+		t.Errorf("Expected BLOCK_IP from the violation_actions block, got %s", action)
+	}
+	if action := getApiSecurityViolationAction(d, "invalid_param_name_violation_action", "ALERT_ONLY"); action != "DEFAULT" {
+		t.Errorf("Expected DEFAULT from the violation_actions block, got %s", action)
+	}
+
+	// Deprecated argument
+	d = schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"api_id":                         1,
+		"method":                         "GET",
+		"path":                           "/users",
+		"missing_param_violation_action": "IGNORE",
+	})
+	if action := getApiSecurityViolationAction(d, "missing_param_violation_action", "ALERT_ONLY"); action != "IGNORE" {
+		t.Errorf("Expected IGNORE from the deprecated argument, got %s", action)
+	}
+
+	// Neither is set
+	if action := getApiSecurityViolationAction(d, "invalid_param_value_violation_action", "ALERT_ONLY"); action != "ALERT_ONLY" {
+		t.Errorf("Expected the ALERT_ONLY default, got %s", action)
+	}
+}
+
+func TestFindApiSecurityEndpoint(t *testing.T) {
+	endpoints := []EndpointResponse{
+		{Id: 1, Method: "GET", Path: "/v1/users"},
+		{Id: 2, Method: "POST", Path: "/v1/users"},
+	}
+	keys := &apiSecurityEndpointKeys{basePath: "/v1", specificationKeys: []string{"GET /users", "POST /users"}}
+
+	if endpoint, found := findApiSecurityEndpoint(endpoints, "POST /users", keys); !found || endpoint.Id != 2 {
+		t.Errorf("Expected the endpoint of the specification path with the base path, got: %v %+v", found, endpoint)
+	}
+	if endpoint, found := findApiSecurityEndpoint(endpoints, "GET /v1/users", nil); !found || endpoint.Id != 1 {
+		t.Errorf("Expected the endpoint of the API path without specification, got: %v %+v", found, endpoint)
+	}
+	if _, found := findApiSecurityEndpoint(endpoints, "GET /users", nil); found {
+		t.Errorf("Should not have found the specification path without the base path")
+	}
+	if path := keys.path("get", "/v1/users"); path != "/users" {
+		t.Errorf("Expected the specification path /users, got: %s", path)
+	}
+}
@@ -0,0 +1,202 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+)
+
+// parseApiSpecification parses an OAS2 or OAS3 document written in JSON or YAML
+func parseApiSpecification(specification string) (map[string]interface{}, error) {
+	var document interface{}
+	if err := json.Unmarshal([]byte(specification), &document); err != nil {
+		var yamlDocument interface{}
+		if yamlErr := yaml.Unmarshal([]byte(specification), &yamlDocument); yamlErr != nil {
+			return nil, fmt.Errorf("API specification is neither valid JSON nor valid YAML: %s", yamlErr)
+		}
+		document = convertYAMLToJSONValue(yamlDocument)
+	}
+
+	spec, ok := document.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("API specification must be an object")
+	}
+
+	swagger, isOAS2 := spec["swagger"]
+	openapi, isOAS3 := spec["openapi"]
+	switch {
+	case isOAS2:
+		if getApiSpecificationVersion(swagger) != "2.0" {
+			return nil, fmt.Errorf("unsupported swagger version %v, expected 2.0", swagger)
+		}
+	case isOAS3:
+		if !strings.HasPrefix(getApiSpecificationVersion(openapi), "3.") {
+			return nil, fmt.Errorf("unsupported openapi version %v, expected 3.x", openapi)
+		}
+	default:
+		return nil, fmt.Errorf("API specification must have a swagger (OAS2) or openapi (OAS3) version field")
+	}
+
+	if _, ok := spec["info"].(map[string]interface{}); !ok {
+		return nil, fmt.Errorf("API specification must have an info object")
+	}
+	if _, ok := spec["paths"].(map[string]interface{}); !ok {
+		return nil, fmt.Errorf("API specification must have a paths object")
+	}
+
+	return spec, nil
+}
+
+// getApiSpecificationVersion returns the swagger or openapi version of a specification as a string,
+// keeping the minor version of unquoted versions such as swagger: 2.0 which are decoded as numbers
+func getApiSpecificationVersion(version interface{}) string {
+	number, ok := version.(float64)
+	if !ok {
+		return fmt.Sprint(version)
+	}
+
+	formatted := strconv.FormatFloat(number, 'f', -1, 64)
+	if !strings.Contains(formatted, ".") {
+		formatted += ".0"
+	}
+	return formatted
+}
+
+// convertYAMLToJSONValue converts the maps decoded from YAML to JSON compatible maps with string keys
+func convertYAMLToJSONValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		converted := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			converted[fmt.Sprint(key)] = convertYAMLToJSONValue(item)
+		}
+		return converted
+	case []interface{}:
+		converted := make([]interface{}, len(v))
+		for i, item := range v {
+			converted[i] = convertYAMLToJSONValue(item)
+		}
+		return converted
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case uint64:
+		return float64(v)
+	default:
+		return v
+	}
+}
+
+// normalizeApiSpecification returns the canonical JSON form of the specification, used for comparison
+func normalizeApiSpecification(specification string) (string, error) {
+	spec, err := parseApiSpecification(specification)
+	if err != nil {
+		return "", err
+	}
+
+	normalized, err := json.Marshal(spec)
+	if err != nil {
+		return "", err
+	}
+
+	return string(normalized), nil
+}
+
+// getApiSpecificationHostAndBasePath returns the host and base path declared in the specification.
+// OAS2 uses host and basePath, OAS3 uses the url of the first server, with its variables defaults.
+func getApiSpecificationHostAndBasePath(spec map[string]interface{}) (string, string) {
+	if _, ok := spec["swagger"]; ok {
+		host, _ := spec["host"].(string)
+		basePath, _ := spec["basePath"].(string)
+		return host, basePath
+	}
+
+	servers, _ := spec["servers"].([]interface{})
+	if len(servers) == 0 {
+		return "", ""
+	}
+	server, _ := servers[0].(map[string]interface{})
+	serverURL, _ := server["url"].(string)
+	variables, _ := server["variables"].(map[string]interface{})
+	for name, variable := range variables {
+		if variableMap, ok := variable.(map[string]interface{}); ok {
+			serverURL = strings.ReplaceAll(serverURL, "{"+name+"}", fmt.Sprint(variableMap["default"]))
+		}
+	}
+
+	parsedURL, err := url.Parse(serverURL)
+	if err != nil {
+		return "", ""
+	}
+
+	return parsedURL.Host, parsedURL.Path
+}
+
+// HTTP methods which can be declared as operations of an OpenAPI path
+var apiSpecificationMethods = []string{"get", "put", "post", "delete", "options", "head", "patch", "trace"}
+
+// apiSecurityEndpointKey identifies an endpoint by method and path, e.g. "GET /users"
+func apiSecurityEndpointKey(method, path string) string {
+	return strings.ToUpper(method) + " " + path
+}
+
+// getApiSpecificationEndpointKeys returns the sorted keys of the endpoints declared in the specification paths
+func getApiSpecificationEndpointKeys(spec map[string]interface{}) []string {
+	keys := []string{}
+	paths, _ := spec["paths"].(map[string]interface{})
+	for path, operations := range paths {
+		operationsMap, ok := operations.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, method := range apiSpecificationMethods {
+			if _, ok := operationsMap[method]; ok {
+				keys = append(keys, apiSecurityEndpointKey(method, path))
+			}
+		}
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// getApiSpecificationEndpointKey returns the specification key of an endpoint, the endpoint path may be returned
+// with the base path prefix while the specification paths are relative to the base path
+func getApiSpecificationEndpointKey(method, path, basePath string, specificationKeys []string) string {
+	key := apiSecurityEndpointKey(method, path)
+	basePath = strings.TrimSuffix(basePath, "/")
+	if basePath == "" || !strings.HasPrefix(path, basePath+"/") {
+		return key
+	}
+
+	relativeKey := apiSecurityEndpointKey(method, strings.TrimPrefix(path, basePath))
+	keys := make(map[string]bool, len(specificationKeys))
+	for _, specificationKey := range specificationKeys {
+		keys[specificationKey] = true
+	}
+	if !keys[key] && keys[relativeKey] {
+		return relativeKey
+	}
+
+	return key
+}
+
+func validateApiSpecification(i interface{}, k string) (warnings []string, errors []error) {
+	specification, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return warnings, errors
+	}
+
+	if _, err := parseApiSpecification(specification); err != nil {
+		errors = append(errors, fmt.Errorf("%s: %s", k, err))
+	}
+
+	return warnings, errors
+}
@@ -0,0 +1,164 @@
+package incapsula
+
+import (
+	"testing"
+)
+
+const testApiSpecificationOAS2YAML = `swagger: "2.0"
+info:
+  title: Sample API
+  version: 1.0.0
+host: api.example.com
+basePath: /v1
+paths:
+  /users:
+    get:
+      responses:
+        200:
+          description: OK
+`
+
+const testApiSpecificationOAS2JSON = `{
+  "paths": {"/users": {"get": {"responses": {"200": {"description": "OK"}}}}},
+  "basePath": "/v1",
+  "host": "api.example.com",
+  "info": {"version": "1.0.0", "title": "Sample API"},
+  "swagger": "2.0"
+}`
+
+const testApiSpecificationOAS3YAML = `openapi: 3.0.1
+info:
+  title: Sample API
+  version: 1.0.0
+servers:
+  - url: https://{environment}.example.com/api/v2
+    variables:
+      environment:
+        default: prod
+  - url: https://staging.example.com/api/v2
+paths: {}
+`
+
+func TestNormalizeApiSpecificationYAMLAndJSON(t *testing.T) {
+	fromYAML, err := normalizeApiSpecification(testApiSpecificationOAS2YAML)
+	if err != nil {
+		t.Fatalf("Should not have received an error for the YAML specification, got: %s", err)
+	}
+	fromJSON, err := normalizeApiSpecification(testApiSpecificationOAS2JSON)
+	if err != nil {
+		t.Fatalf("Should not have received an error for the JSON specification, got: %s", err)
+	}
+
+	if fromYAML != fromJSON {
+		t.Errorf("Normalized specifications should be equal:\n%s\n%s", fromYAML, fromJSON)
+	}
+}
+
+func TestParseApiSpecificationInvalid(t *testing.T) {
+	specifications := map[string]string{
+		"not a document":  "foo: [bar",
+		"not an object":   "- foo\n- bar\n",
+		"no version":      "info: {title: a, version: '1'}\npaths: {}\n",
+		"bad swagger":     "swagger: '1.2'\ninfo: {title: a, version: '1'}\npaths: {}\n",
+		"bad openapi":     "openapi: 2.0.0\ninfo: {title: a, version: '1'}\npaths: {}\n",
+		"no info":         "openapi: 3.0.0\npaths: {}\n",
+		"no paths":        "openapi: 3.0.0\ninfo: {title: a, version: '1'}\n",
+		"paths not a map": "openapi: 3.0.0\ninfo: {title: a, version: '1'}\npaths: []\n",
+	}
+
+	for name, specification := range specifications {
+		if _, err := parseApiSpecification(specification); err == nil {
+			t.Errorf("Should have received an error for specification: %s", name)
+		}
+	}
+}
+
+func TestParseApiSpecificationUnquotedVersion(t *testing.T) {
+	specifications := map[string]string{
+		"swagger 2.0":      "swagger: 2.0\ninfo: {title: a, version: '1'}\npaths: {}\n",
+		"openapi 3.0":      "openapi: 3.0\ninfo: {title: a, version: '1'}\npaths: {}\n",
+		"openapi 3.1":      "openapi: 3.1\ninfo: {title: a, version: '1'}\npaths: {}\n",
+		"json swagger 2.0": `{"swagger": 2.0, "info": {"title": "a", "version": "1"}, "paths": {}}`,
+	}
+
+	for name, specification := range specifications {
+		if _, err := parseApiSpecification(specification); err != nil {
+			t.Errorf("Should not have received an error for specification %s, got: %s", name, err)
+		}
+	}
+
+	invalid := map[string]string{
+		"swagger 2.1": "swagger: 2.1\ninfo: {title: a, version: '1'}\npaths: {}\n",
+		"openapi 2.0": "openapi: 2.0\ninfo: {title: a, version: '1'}\npaths: {}\n",
+	}
+
+	for name, specification := range invalid {
+		if _, err := parseApiSpecification(specification); err == nil {
+			t.Errorf("Should have received an error for specification: %s", name)
+		}
+	}
+}
+
+func TestGetApiSpecificationHostAndBasePath(t *testing.T) {
+	spec, err := parseApiSpecification(testApiSpecificationOAS2YAML)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	host, basePath := getApiSpecificationHostAndBasePath(spec)
+	if host != "api.example.com" || basePath != "/v1" {
+		t.Errorf("Unexpected OAS2 host (%s) and base path (%s)", host, basePath)
+	}
+
+	spec, err = parseApiSpecification(testApiSpecificationOAS3YAML)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	host, basePath = getApiSpecificationHostAndBasePath(spec)
+	if host != "prod.example.com" || basePath != "/api/v2" {
+		t.Errorf("Unexpected OAS3 host (%s) and base path (%s)", host, basePath)
+	}
+}
+
+func TestGetApiSpecificationEndpointKeys(t *testing.T) {
+	spec, err := parseApiSpecification(`openapi: 3.0.1
+info: {title: Sample API, version: 1.0.0}
+paths:
+  /users:
+    get: {}
+    post: {}
+    parameters: []
+  /users/{id}:
+    delete: {}
+`)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+
+	keys := getApiSpecificationEndpointKeys(spec)
+	expected := []string{"DELETE /users/{id}", "GET /users", "POST /users"}
+	if len(keys) != len(expected) {
+		t.Fatalf("Expected endpoint keys %v, got %v", expected, keys)
+	}
+	for i := range expected {
+		if keys[i] != expected[i] {
+			t.Errorf("Expected endpoint keys %v, got %v", expected, keys)
+		}
+	}
+}
+
+func TestGetApiSpecificationEndpointKey(t *testing.T) {
+	specificationKeys := []string{"GET /users", "POST /v1/orders"}
+	endpoints := map[[2]string]string{
+		{"get", "/v1/users"}:    "GET /users",
+		{"GET", "/users"}:       "GET /users",
+		{"POST", "/v1/orders"}:  "POST /v1/orders",
+		{"GET", "/v1/products"}: "GET /v1/products",
+		{"GET", "/v1x/users"}:   "GET /v1x/users",
+	}
+
+	for endpoint, expected := range endpoints {
+		if key := getApiSpecificationEndpointKey(endpoint[0], endpoint[1], "/v1/", specificationKeys); key != expected {
+			t.Errorf("Key of endpoint %v should be %q, got %q", endpoint, expected, key)
+		}
+	}
+}
@@ -17,9 +17,10 @@ const contentTypeApplicationJson = "application/json"
 
 // Client represents an internal client that brokers calls to the Incapsula API
 type Client struct {
-	config          *Config
-	httpClient      *http.Client
-	providerVersion string
+	config           *Config
+	httpClient       *http.Client
+	providerVersion  string
+	dataCenterModels siteDataCenterModels
 }
 
 // NewClient creates a new client with the provided configuration
@@ -155,3 +156,13 @@ func SetHeaders(c *Client, req *http.Request, contentType string, operation stri
 	}
 
 }
+
+// formatApiErrors returns the errors of an API response as JSON
+func formatApiErrors(errors []ApiError) string {
+	out, err := json.Marshal(errors)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(out)
+}
@@ -0,0 +1,106 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+)
+
+const endpointAccountApiKeys = "identity-management/v3/api-keys"
+
+// AccountApiKey is an API ID and key of an account, the key is only returned when the API key is created
+type AccountApiKey struct {
+	ID                     int    `json:"id,omitempty"`
+	AccountID              int    `json:"accountId,omitempty"`
+	Name                   string `json:"name"`
+	Description            string `json:"description"`
+	Status                 string `json:"status"`
+	ExpirationPeriodInDays int    `json:"expirationPeriodInDays,omitempty"`
+	ExpirationDate         string `json:"expirationDate,omitempty"`
+	ApiKey                 string `json:"apiKey,omitempty"`
+}
+
+// Same DTO for: GET response, POST/PUT request, and POST/PUT response
+type AccountApiKeyDTO struct {
+	Errors []ApiError      `json:"errors"`
+	Data   []AccountApiKey `json:"data"`
+}
+
+// AddAccountApiKey creates an API key for an account, accountID 0 is the account of the API credentials
+func (c *Client) AddAccountApiKey(accountID int, apiKey AccountApiKey) (*AccountApiKeyDTO, error) {
+	log.Printf("[INFO] Adding Incapsula API key %s for account: %d\n", apiKey.Name, accountID)
+
+	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURLAPI, endpointAccountApiKeys)
+	return c.sendAccountApiKeyRequest(http.MethodPost, reqURL, accountID, &AccountApiKeyDTO{Data: []AccountApiKey{apiKey}}, CreateAccountApiKey)
+}
+
+// GetAccountApiKey gets an API key of an account, without the key
+func (c *Client) GetAccountApiKey(accountID, apiKeyID int) (*AccountApiKeyDTO, error) {
+	log.Printf("[INFO] Getting Incapsula API key %d of account: %d\n", apiKeyID, accountID)
+
+	reqURL := fmt.Sprintf("%s/%s/%d", c.config.BaseURLAPI, endpointAccountApiKeys, apiKeyID)
+	return c.sendAccountApiKeyRequest(http.MethodGet, reqURL, accountID, nil, ReadAccountApiKey)
+}
+
+// ListAccountApiKeys lists the API keys of an account, without the keys
+func (c *Client) ListAccountApiKeys(accountID int) (*AccountApiKeyDTO, error) {
+	log.Printf("[INFO] Listing Incapsula API keys of account: %d\n", accountID)
+
+	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURLAPI, endpointAccountApiKeys)
+	return c.sendAccountApiKeyRequest(http.MethodGet, reqURL, accountID, nil, ReadAccountApiKey)
+}
+
+// UpdateAccountApiKey updates the name, description and status of an API key
+func (c *Client) UpdateAccountApiKey(accountID, apiKeyID int, apiKey AccountApiKey) (*AccountApiKeyDTO, error) {
+	log.Printf("[INFO] Updating Incapsula API key %d of account: %d\n", apiKeyID, accountID)
+
+	reqURL := fmt.Sprintf("%s/%s/%d", c.config.BaseURLAPI, endpointAccountApiKeys, apiKeyID)
+	return c.sendAccountApiKeyRequest(http.MethodPut, reqURL, accountID, &AccountApiKeyDTO{Data: []AccountApiKey{apiKey}}, UpdateAccountApiKey)
+}
+
+// DeleteAccountApiKey revokes an API key of an account
+func (c *Client) DeleteAccountApiKey(accountID, apiKeyID int) (*AccountApiKeyDTO, error) {
+	log.Printf("[INFO] Revoking Incapsula API key %d of account: %d\n", apiKeyID, accountID)
+
+	reqURL := fmt.Sprintf("%s/%s/%d", c.config.BaseURLAPI, endpointAccountApiKeys, apiKeyID)
+	return c.sendAccountApiKeyRequest(http.MethodDelete, reqURL, accountID, nil, DeleteAccountApiKey)
+}
+
+func (c *Client) sendAccountApiKeyRequest(method, reqURL string, accountID int, requestDTO *AccountApiKeyDTO, operation string) (*AccountApiKeyDTO, error) {
+	var requestJSON []byte
+	if requestDTO != nil {
+		var err error
+		requestJSON, err = json.Marshal(requestDTO)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to JSON marshal API key: %s", err)
+		}
+	}
+
+	resp, err := c.DoJsonAndQueryParamsRequestWithHeaders(method, reqURL, requestJSON, GetRequestParamsWithCaid(accountID), operation)
+	if err != nil {
+		return nil, fmt.Errorf("Error executing %s API key request for account %d: %s", method, accountID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON, without the key
+	log.Printf("[DEBUG] Incapsula %s API key response status: %d\n", method, resp.StatusCode)
+
+	// Parse the JSON
+	var responseDTO AccountApiKeyDTO
+	if len(responseBody) > 0 {
+		err = json.Unmarshal([]byte(responseBody), &responseDTO)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing %s API key JSON response for account %d: %s", method, accountID, err)
+		}
+	}
+	if resp.StatusCode != 200 && len(responseDTO.Errors) == 0 {
+		responseDTO.Errors = []ApiError{{Status: fmt.Sprint(resp.StatusCode), Message: string(responseBody)}}
+	}
+
+	return &responseDTO, nil
+}
@@ -0,0 +1,114 @@
+package incapsula
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClientAddAccountApiKeyBadConnection(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+	responseDTO, err := client.AddAccountApiKey(123, AccountApiKey{Name: "ci"})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error executing POST API key request for account 123") {
+		t.Errorf("Should have received an client error, got: %s", err)
+	}
+	if responseDTO != nil {
+		t.Errorf("Should have received a nil response")
+	}
+}
+
+func TestClientAddAccountApiKeyValidApiKey(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost || req.URL.String() != fmt.Sprintf("/%s?caid=123", endpointAccountApiKeys) {
+			t.Errorf("Should have have hit POST /%s?caid=123 endpoint. Got: %s %s", endpointAccountApiKeys, req.Method, req.URL.String())
+		}
+		body, _ := ioutil.ReadAll(req.Body)
+		if string(body) != `{"errors":null,"data":[{"name":"ci","description":"","status":"ENABLED","expirationPeriodInDays":90}]}` {
+			t.Errorf("Unexpected request body: %s", string(body))
+		}
+		rw.Write([]byte(`{"data":[{"id":42,"accountId":123,"name":"ci","status":"ENABLED","expirationDate":"2027-01-16","apiKey":"secret"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	responseDTO, err := client.AddAccountApiKey(123, AccountApiKey{Name: "ci", Status: "ENABLED", ExpirationPeriodInDays: 90})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if len(responseDTO.Errors) != 0 || len(responseDTO.Data) != 1 {
+		t.Fatalf("Unexpected response: %+v", responseDTO)
+	}
+	if apiKey := responseDTO.Data[0]; apiKey.ID != 42 || apiKey.AccountID != 123 || apiKey.ApiKey != "secret" {
+		t.Errorf("Unexpected API key: %+v", apiKey)
+	}
+}
+
+func TestClientGetAccountApiKeyNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s/42", endpointAccountApiKeys) {
+			t.Errorf("Should have have hit /%s/42 endpoint. Got: %s", endpointAccountApiKeys, req.URL.String())
+		}
+		rw.WriteHeader(404)
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	responseDTO, err := client.GetAccountApiKey(0, 42)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if len(responseDTO.Errors) != 1 || responseDTO.Errors[0].Status != "404" {
+		t.Errorf("Should have received a 404 error, got: %+v", responseDTO)
+	}
+}
+
+func TestClientListAccountApiKeysValidApiKeys(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet || req.URL.String() != fmt.Sprintf("/%s?caid=123", endpointAccountApiKeys) {
+			t.Errorf("Should have have hit GET /%s?caid=123 endpoint. Got: %s %s", endpointAccountApiKeys, req.Method, req.URL.String())
+		}
+		rw.Write([]byte(`{"data":[{"id":42,"name":"ci","status":"ENABLED"},{"id":43,"name":"backup","status":"DISABLED"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	responseDTO, err := client.ListAccountApiKeys(123)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if len(responseDTO.Data) != 2 || responseDTO.Data[1].Status != "DISABLED" {
+		t.Errorf("Unexpected response: %+v", responseDTO)
+	}
+}
+
+func TestClientDeleteAccountApiKeyBadStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodDelete {
+			t.Errorf("Should have have hit DELETE endpoint. Got: %s", req.Method)
+		}
+		rw.WriteHeader(401)
+		rw.Write([]byte(`{"errors":[{"status":"401","message":"Unauthorized"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	responseDTO, err := client.DeleteAccountApiKey(123, 42)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if len(responseDTO.Errors) != 1 || responseDTO.Errors[0].Message != "Unauthorized" {
+		t.Errorf("Unexpected response: %+v", responseDTO)
+	}
+}
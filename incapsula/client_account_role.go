@@ -0,0 +1,162 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+)
+
+const endpointUserManagementRoles = "user-management/v1/roles"
+const endpointUserManagementAbilities = "user-management/v1/abilities"
+
+// Ability is an ability of the abilities catalogue of an account, which can be granted to a role
+type Ability struct {
+	AbilityKey           string `json:"abilityKey"`
+	AbilityDisplayName   string `json:"abilityDisplayName"`
+	IsRegularUserAbility bool   `json:"isRegularUserAbility"`
+}
+
+// AccountRoleRequest is the body of the requests creating or updating a role
+type AccountRoleRequest struct {
+	RoleName        string   `json:"roleName"`
+	RoleDescription string   `json:"roleDescription"`
+	AccountID       int      `json:"accountId,omitempty"`
+	RoleAbilities   []string `json:"roleAbilities"`
+}
+
+// AccountRoleResponse is a role, with the users it's assigned to
+type AccountRoleResponse struct {
+	RoleID          int       `json:"roleId"`
+	RoleName        string    `json:"roleName"`
+	RoleDescription string    `json:"roleDescription"`
+	AccountID       int       `json:"accountId"`
+	AccountName     string    `json:"accountName"`
+	RoleAbilities   []Ability `json:"roleAbilities"`
+	UserAssignment  []struct {
+		UserID    int    `json:"userId"`
+		AccountID int    `json:"accountId"`
+		Email     string `json:"email"`
+	} `json:"userAssignment"`
+	IsEditable bool `json:"isEditable"`
+}
+
+// GetAccountAbilities gets the abilities catalogue of an account, accountID 0 is the account of the API credentials
+func (c *Client) GetAccountAbilities(accountID int) ([]Ability, error) {
+	log.Printf("[INFO] Getting Incapsula abilities of account: %d\n", accountID)
+
+	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURLAPI, endpointUserManagementAbilities)
+	resp, err := c.DoJsonAndQueryParamsRequestWithHeaders(http.MethodGet, reqURL, nil, GetRequestParamsWithCaid(accountID), ReadAccountAbilities)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting abilities of account %d: %s", accountID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula abilities JSON response: %s\n", string(responseBody))
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("Error status code %d from Incapsula service when getting abilities of account %d: %s", resp.StatusCode, accountID, string(responseBody))
+	}
+
+	// Parse the JSON
+	var abilities []Ability
+	err = json.Unmarshal([]byte(responseBody), &abilities)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing abilities JSON response of account %d: %s\nresponse: %s", accountID, err, string(responseBody))
+	}
+
+	return abilities, nil
+}
+
+// AddAccountRole adds a role to an account
+func (c *Client) AddAccountRole(role *AccountRoleRequest) (*AccountRoleResponse, error) {
+	log.Printf("[INFO] Adding Incapsula role: %s\n", role.RoleName)
+
+	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURLAPI, endpointUserManagementRoles)
+	return c.sendAccountRoleRequest(http.MethodPost, reqURL, role, CreateAccountRole, fmt.Sprintf("adding role %s", role.RoleName))
+}
+
+// UpdateAccountRole updates the name, description and abilities of a role
+func (c *Client) UpdateAccountRole(roleID int, role *AccountRoleRequest) (*AccountRoleResponse, error) {
+	log.Printf("[INFO] Updating Incapsula role: %d\n", roleID)
+
+	reqURL := fmt.Sprintf("%s/%s/%d", c.config.BaseURLAPI, endpointUserManagementRoles, roleID)
+	return c.sendAccountRoleRequest(http.MethodPut, reqURL, role, UpdateAccountRole, fmt.Sprintf("updating role %d", roleID))
+}
+
+// GetAccountRole gets a role, nil when the role doesn't exist
+func (c *Client) GetAccountRole(roleID int) (*AccountRoleResponse, error) {
+	log.Printf("[INFO] Getting Incapsula role: %d\n", roleID)
+
+	reqURL := fmt.Sprintf("%s/%s/%d", c.config.BaseURLAPI, endpointUserManagementRoles, roleID)
+	return c.sendAccountRoleRequest(http.MethodGet, reqURL, nil, ReadAccountRole, fmt.Sprintf("getting role %d", roleID))
+}
+
+// DeleteAccountRole deletes a role
+func (c *Client) DeleteAccountRole(roleID int) error {
+	log.Printf("[INFO] Deleting Incapsula role: %d\n", roleID)
+
+	reqURL := fmt.Sprintf("%s/%s/%d", c.config.BaseURLAPI, endpointUserManagementRoles, roleID)
+	resp, err := c.DoJsonRequestWithHeaders(http.MethodDelete, reqURL, nil, DeleteAccountRole)
+	if err != nil {
+		return fmt.Errorf("Error deleting role %d: %s", roleID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula delete role JSON response: %s\n", string(responseBody))
+
+	if resp.StatusCode != 200 && resp.StatusCode != 404 {
+		return fmt.Errorf("Error status code %d from Incapsula service when deleting role %d: %s", resp.StatusCode, roleID, string(responseBody))
+	}
+
+	return nil
+}
+
+func (c *Client) sendAccountRoleRequest(method, reqURL string, role *AccountRoleRequest, operation, description string) (*AccountRoleResponse, error) {
+	var roleJSON []byte
+	if role != nil {
+		var err error
+		roleJSON, err = json.Marshal(role)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to JSON marshal role: %s", err)
+		}
+		log.Printf("[DEBUG] Incapsula role JSON request: %s\n", string(roleJSON))
+	}
+
+	resp, err := c.DoJsonRequestWithHeaders(method, reqURL, roleJSON, operation)
+	if err != nil {
+		return nil, fmt.Errorf("Error %s: %s", description, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula role JSON response: %s\n", string(responseBody))
+
+	if method == http.MethodGet && resp.StatusCode == 404 {
+		return nil, nil
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("Error status code %d from Incapsula service when %s: %s", resp.StatusCode, description, string(responseBody))
+	}
+
+	// Parse the JSON
+	var roleResponse AccountRoleResponse
+	err = json.Unmarshal([]byte(responseBody), &roleResponse)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing role JSON response when %s: %s\nresponse: %s", description, err, string(responseBody))
+	}
+
+	return &roleResponse, nil
+}
@@ -0,0 +1,127 @@
+package incapsula
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClientAddAccountRoleBadConnection(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+	role, err := client.AddAccountRole(&AccountRoleRequest{RoleName: "auditor"})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error adding role auditor") {
+		t.Errorf("Should have received an client error, got: %s", err)
+	}
+	if role != nil {
+		t.Errorf("Should have received a nil role instance")
+	}
+}
+
+func TestClientAddAccountRoleValidRole(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost || req.URL.String() != fmt.Sprintf("/%s", endpointUserManagementRoles) {
+			t.Errorf("Should have have hit POST /%s endpoint. Got: %s %s", endpointUserManagementRoles, req.Method, req.URL.String())
+		}
+		body, _ := ioutil.ReadAll(req.Body)
+		if string(body) != `{"roleName":"auditor","roleDescription":"","accountId":123,"roleAbilities":["canViewAuditTrail"]}` {
+			t.Errorf("Unexpected request body: %s", string(body))
+		}
+		rw.Write([]byte(`{"roleId":42,"roleName":"auditor","accountId":123,"roleAbilities":[{"abilityKey":"canViewAuditTrail","abilityDisplayName":"View audit trail"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	role, err := client.AddAccountRole(&AccountRoleRequest{RoleName: "auditor", AccountID: 123, RoleAbilities: []string{"canViewAuditTrail"}})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if role == nil || role.RoleID != 42 || len(role.RoleAbilities) != 1 || role.RoleAbilities[0].AbilityKey != "canViewAuditTrail" {
+		t.Errorf("Unexpected role: %+v", role)
+	}
+}
+
+func TestClientGetAccountRoleNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s/42", endpointUserManagementRoles) {
+			t.Errorf("Should have have hit /%s/42 endpoint. Got: %s", endpointUserManagementRoles, req.URL.String())
+		}
+		rw.WriteHeader(404)
+		rw.Write([]byte(`{"errors":[{"status":"404","detail":"Role not found"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	role, err := client.GetAccountRole(42)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if role != nil {
+		t.Errorf("Should have received a nil role instance")
+	}
+}
+
+func TestClientUpdateAccountRoleBadStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(400)
+		rw.Write([]byte(`{"errors":[{"status":"400","detail":"Unknown ability"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	role, err := client.UpdateAccountRole(42, &AccountRoleRequest{RoleName: "auditor"})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error status code 400 from Incapsula service when updating role 42") {
+		t.Errorf("Should have received a bad status error, got: %s", err)
+	}
+	if role != nil {
+		t.Errorf("Should have received a nil role instance")
+	}
+}
+
+func TestClientDeleteAccountRoleValidRole(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodDelete || req.URL.String() != fmt.Sprintf("/%s/42", endpointUserManagementRoles) {
+			t.Errorf("Should have have hit DELETE /%s/42 endpoint. Got: %s %s", endpointUserManagementRoles, req.Method, req.URL.String())
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	if err := client.DeleteAccountRole(42); err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
+
+func TestClientGetAccountAbilitiesValidAccount(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s?caid=123", endpointUserManagementAbilities) {
+			t.Errorf("Should have have hit /%s?caid=123 endpoint. Got: %s", endpointUserManagementAbilities, req.URL.String())
+		}
+		rw.Write([]byte(`[{"abilityKey":"canAddSite","abilityDisplayName":"Add sites","isRegularUserAbility":true}]`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	abilities, err := client.GetAccountAbilities(123)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if len(abilities) != 1 || abilities[0].AbilityKey != "canAddSite" || abilities[0].AbilityDisplayName != "Add sites" {
+		t.Errorf("Unexpected abilities: %+v", abilities)
+	}
+}
@@ -0,0 +1,108 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+)
+
+const endpointAccountSSLSettings = "certificates-ui/v3/account/ssl-settings"
+
+// AccountSSLSettingsHSTS is the HSTS configuration of the new sites of the account
+type AccountSSLSettingsHSTS struct {
+	MaxAge             int  `json:"maxAge"`
+	SubDomainsIncluded bool `json:"subDomainsIncluded"`
+	PreLoaded          bool `json:"preLoaded"`
+}
+
+// AccountSSLSettingsDelegation is the delegation of the domain validation of the Imperva certificates by CNAME
+type AccountSSLSettingsDelegation struct {
+	AllowCNAMEValidation             bool     `json:"allowCNAMEValidation"`
+	AllowedDomainsForCNAMEValidation []string `json:"allowedDomainsForCNAMEValidation"`
+	ValueForCNAMEValidation          string   `json:"valueForCNAMEValidation,omitempty"`
+}
+
+// AccountSSLSettingsImpervaCertificate is the default configuration of the Imperva certificates of the new sites
+type AccountSSLSettingsImpervaCertificate struct {
+	AddNakedDomainSanForWWWSites  bool                          `json:"addNakedDomainSanForWWWSites"`
+	UseWildCardSanInsteadOfFQDN   bool                          `json:"useWildCardSanInsteadOfFQDN"`
+	DefaultValidationMethod       string                        `json:"defaultValidationMethod,omitempty"`
+	AllowedCertificateAuthorities []string                      `json:"allowedCertificateAuthorities"`
+	Delegation                    *AccountSSLSettingsDelegation `json:"delegation,omitempty"`
+}
+
+// AccountSSLSettings are the SSL defaults of an account
+type AccountSSLSettings struct {
+	AllowSupportOldTLSVersions bool                                  `json:"allowSupportOldTLSVersions"`
+	EnableHSTSForNewSites      bool                                  `json:"enableHSTSForNewSites"`
+	HSTS                       *AccountSSLSettingsHSTS               `json:"hstsDefaults,omitempty"`
+	ImpervaCertificate         *AccountSSLSettingsImpervaCertificate `json:"impervaCertificate,omitempty"`
+}
+
+// Same DTO for: GET response, PATCH request, and PATCH response
+type AccountSSLSettingsDTO struct {
+	Errors []ApiError           `json:"errors"`
+	Data   []AccountSSLSettings `json:"data"`
+}
+
+// GetAccountSSLSettings gets the SSL defaults of an account
+func (c *Client) GetAccountSSLSettings(accountID int) (*AccountSSLSettingsDTO, error) {
+	log.Printf("[INFO] Getting Incapsula SSL settings of account: %d\n", accountID)
+
+	return c.sendAccountSSLSettingsRequest(http.MethodGet, accountID, nil, ReadAccountSSLSettings)
+}
+
+// UpdateAccountSSLSettings updates the SSL defaults of an account
+func (c *Client) UpdateAccountSSLSettings(accountID int, requestDTO *AccountSSLSettingsDTO) (*AccountSSLSettingsDTO, error) {
+	log.Printf("[INFO] Updating Incapsula SSL settings of account: %d\n", accountID)
+
+	return c.sendAccountSSLSettingsRequest(http.MethodPatch, accountID, requestDTO, UpdateAccountSSLSettings)
+}
+
+// DeleteAccountSSLSettings resets the SSL defaults of an account to the defaults of Imperva, or of the parent account
+func (c *Client) DeleteAccountSSLSettings(accountID int) (*AccountSSLSettingsDTO, error) {
+	log.Printf("[INFO] Resetting Incapsula SSL settings of account: %d\n", accountID)
+
+	return c.sendAccountSSLSettingsRequest(http.MethodDelete, accountID, nil, DeleteAccountSSLSettings)
+}
+
+func (c *Client) sendAccountSSLSettingsRequest(method string, accountID int, requestDTO *AccountSSLSettingsDTO, operation string) (*AccountSSLSettingsDTO, error) {
+	var requestJSON []byte
+	if requestDTO != nil {
+		var err error
+		requestJSON, err = json.Marshal(requestDTO)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to JSON marshal SSL settings: %s", err)
+		}
+		log.Printf("[DEBUG] Incapsula %s SSL settings JSON request: %s\n", method, string(requestJSON))
+	}
+
+	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURLAPI, endpointAccountSSLSettings)
+	resp, err := c.DoJsonAndQueryParamsRequestWithHeaders(method, reqURL, requestJSON, GetRequestParamsWithCaid(accountID), operation)
+	if err != nil {
+		return nil, fmt.Errorf("Error executing %s SSL settings request for account %d: %s", method, accountID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula %s SSL settings JSON response: %s\n", method, string(responseBody))
+
+	// Parse the JSON
+	var responseDTO AccountSSLSettingsDTO
+	if len(responseBody) > 0 {
+		err = json.Unmarshal([]byte(responseBody), &responseDTO)
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing %s SSL settings JSON response for account %d: %s\nresponse: %s", method, accountID, err, string(responseBody))
+		}
+	}
+	if resp.StatusCode != 200 && len(responseDTO.Errors) == 0 {
+		responseDTO.Errors = []ApiError{{Status: fmt.Sprint(resp.StatusCode), Message: string(responseBody)}}
+	}
+
+	return &responseDTO, nil
+}
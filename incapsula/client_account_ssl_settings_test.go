@@ -0,0 +1,99 @@
+package incapsula
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClientGetAccountSSLSettingsBadConnection(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+	responseDTO, err := client.GetAccountSSLSettings(123)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error executing GET SSL settings request for account 123") {
+		t.Errorf("Should have received an client error, got: %s", err)
+	}
+	if responseDTO != nil {
+		t.Errorf("Should have received a nil response")
+	}
+}
+
+func TestClientGetAccountSSLSettingsValidSettings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodGet || req.URL.String() != fmt.Sprintf("/%s?caid=123", endpointAccountSSLSettings) {
+			t.Errorf("Should have have hit GET /%s?caid=123 endpoint. Got: %s %s", endpointAccountSSLSettings, req.Method, req.URL.String())
+		}
+		rw.Write([]byte(`{"data":[{"allowSupportOldTLSVersions":true,"enableHSTSForNewSites":true,"hstsDefaults":{"maxAge":31536000,"subDomainsIncluded":true,"preLoaded":false},"impervaCertificate":{"addNakedDomainSanForWWWSites":true,"useWildCardSanInsteadOfFQDN":false,"defaultValidationMethod":"CNAME","allowedCertificateAuthorities":["GLOBALSIGN"],"delegation":{"allowCNAMEValidation":true,"allowedDomainsForCNAMEValidation":["example.com"],"valueForCNAMEValidation":"abc.validation.imperva.com"}}}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	responseDTO, err := client.GetAccountSSLSettings(123)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if len(responseDTO.Errors) != 0 || len(responseDTO.Data) != 1 {
+		t.Fatalf("Unexpected response: %+v", responseDTO)
+	}
+	settings := responseDTO.Data[0]
+	if !settings.AllowSupportOldTLSVersions || settings.HSTS == nil || settings.HSTS.MaxAge != 31536000 {
+		t.Errorf("Unexpected settings: %+v", settings)
+	}
+	if settings.ImpervaCertificate == nil || settings.ImpervaCertificate.DefaultValidationMethod != "CNAME" || settings.ImpervaCertificate.Delegation == nil || settings.ImpervaCertificate.Delegation.ValueForCNAMEValidation != "abc.validation.imperva.com" {
+		t.Errorf("Unexpected Imperva certificate settings: %+v", settings.ImpervaCertificate)
+	}
+}
+
+func TestClientUpdateAccountSSLSettingsValidSettings(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPatch || req.URL.String() != fmt.Sprintf("/%s?caid=123", endpointAccountSSLSettings) {
+			t.Errorf("Should have have hit PATCH /%s?caid=123 endpoint. Got: %s %s", endpointAccountSSLSettings, req.Method, req.URL.String())
+		}
+		body, _ := ioutil.ReadAll(req.Body)
+		if string(body) != `{"errors":null,"data":[{"allowSupportOldTLSVersions":false,"enableHSTSForNewSites":true,"hstsDefaults":{"maxAge":300,"subDomainsIncluded":false,"preLoaded":false}}]}` {
+			t.Errorf("Unexpected request body: %s", string(body))
+		}
+		rw.Write(body)
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	responseDTO, err := client.UpdateAccountSSLSettings(123, &AccountSSLSettingsDTO{
+		Data: []AccountSSLSettings{{EnableHSTSForNewSites: true, HSTS: &AccountSSLSettingsHSTS{MaxAge: 300}}},
+	})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if len(responseDTO.Errors) != 0 || len(responseDTO.Data) != 1 || !responseDTO.Data[0].EnableHSTSForNewSites {
+		t.Errorf("Unexpected response: %+v", responseDTO)
+	}
+}
+
+func TestClientDeleteAccountSSLSettingsBadStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodDelete {
+			t.Errorf("Should have have hit DELETE endpoint. Got: %s", req.Method)
+		}
+		rw.WriteHeader(500)
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	responseDTO, err := client.DeleteAccountSSLSettings(123)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if len(responseDTO.Errors) != 1 || responseDTO.Errors[0].Status != "500" {
+		t.Errorf("Should have received a 500 error, got: %+v", responseDTO)
+	}
+}
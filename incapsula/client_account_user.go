@@ -0,0 +1,174 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+)
+
+const endpointUserManagementUsers = "user-management/v1/users"
+const endpointUserManagementAssignments = "user-management/v1/assignments"
+
+// AccountUserRequest is the body of the request creating a user
+type AccountUserRequest struct {
+	AccountID int    `json:"accountId,omitempty"`
+	Email     string `json:"email"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	RoleIds   []int  `json:"roleIds"`
+}
+
+// AccountUserRole is a role of a user in an account
+type AccountUserRole struct {
+	RoleID   int    `json:"roleId"`
+	RoleName string `json:"roleName"`
+}
+
+// AccountUserResponse is a user, with its roles in the requested account
+type AccountUserResponse struct {
+	UserID    int               `json:"userId"`
+	AccountID int               `json:"accountId"`
+	Email     string            `json:"email"`
+	FirstName string            `json:"firstName"`
+	LastName  string            `json:"lastName"`
+	Roles     []AccountUserRole `json:"roles"`
+}
+
+// AccountUserAssignment sets the roles of a user in an account, no role removes the access of the user to the account
+type AccountUserAssignment struct {
+	AccountID int    `json:"accountId"`
+	Email     string `json:"email"`
+	RoleIds   []int  `json:"roleIds"`
+}
+
+// AddAccountUser adds a user to an account
+func (c *Client) AddAccountUser(user *AccountUserRequest) (*AccountUserResponse, error) {
+	log.Printf("[INFO] Adding Incapsula user %s to account %d\n", user.Email, user.AccountID)
+
+	userJSON, err := json.Marshal(user)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to JSON marshal user: %s", err)
+	}
+	log.Printf("[DEBUG] Incapsula add user JSON request: %s\n", string(userJSON))
+
+	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURLAPI, endpointUserManagementUsers)
+	resp, err := c.DoJsonRequestWithHeaders(http.MethodPost, reqURL, userJSON, CreateAccountUser)
+	if err != nil {
+		return nil, fmt.Errorf("Error adding user %s to account %d: %s", user.Email, user.AccountID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula add user JSON response: %s\n", string(responseBody))
+
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("Error status code %d from Incapsula service when adding user %s to account %d: %s", resp.StatusCode, user.Email, user.AccountID, string(responseBody))
+	}
+
+	// Parse the JSON
+	var userResponse AccountUserResponse
+	err = json.Unmarshal([]byte(responseBody), &userResponse)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing add user JSON response for user %s: %s\nresponse: %s", user.Email, err, string(responseBody))
+	}
+
+	return &userResponse, nil
+}
+
+// GetAccountUser gets a user with its roles in the account, nil when the user has no access to the account
+func (c *Client) GetAccountUser(accountID int, email string) (*AccountUserResponse, error) {
+	log.Printf("[INFO] Getting Incapsula user %s of account %d\n", email, accountID)
+
+	params := GetRequestParamsWithCaid(accountID)
+	params["email"] = email
+
+	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURLAPI, endpointUserManagementUsers)
+	resp, err := c.DoJsonAndQueryParamsRequestWithHeaders(http.MethodGet, reqURL, nil, params, ReadAccountUser)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting user %s of account %d: %s", email, accountID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula get user JSON response: %s\n", string(responseBody))
+
+	if resp.StatusCode == 404 {
+		return nil, nil
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("Error status code %d from Incapsula service when getting user %s of account %d: %s", resp.StatusCode, email, accountID, string(responseBody))
+	}
+
+	// Parse the JSON
+	var userResponse AccountUserResponse
+	err = json.Unmarshal([]byte(responseBody), &userResponse)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing get user JSON response for user %s: %s\nresponse: %s", email, err, string(responseBody))
+	}
+
+	return &userResponse, nil
+}
+
+// AssignAccountUserRoles sets the roles of users in accounts, e.g. to give a user access to a sub-account
+func (c *Client) AssignAccountUserRoles(assignments []AccountUserAssignment) error {
+	log.Printf("[INFO] Assigning Incapsula user roles: %+v\n", assignments)
+
+	assignmentsJSON, err := json.Marshal(assignments)
+	if err != nil {
+		return fmt.Errorf("Failed to JSON marshal user assignments: %s", err)
+	}
+
+	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURLAPI, endpointUserManagementAssignments)
+	resp, err := c.DoJsonRequestWithHeaders(http.MethodPost, reqURL, assignmentsJSON, UpdateAccountUser)
+	if err != nil {
+		return fmt.Errorf("Error assigning user roles: %s", err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula assign user roles JSON response: %s\n", string(responseBody))
+
+	if resp.StatusCode != 200 {
+		return fmt.Errorf("Error status code %d from Incapsula service when assigning user roles: %s", resp.StatusCode, string(responseBody))
+	}
+
+	return nil
+}
+
+// DeleteAccountUser deletes a user of an account
+func (c *Client) DeleteAccountUser(accountID int, email string) error {
+	log.Printf("[INFO] Deleting Incapsula user %s of account %d\n", email, accountID)
+
+	params := GetRequestParamsWithCaid(accountID)
+	params["email"] = email
+
+	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURLAPI, endpointUserManagementUsers)
+	resp, err := c.DoJsonAndQueryParamsRequestWithHeaders(http.MethodDelete, reqURL, nil, params, DeleteAccountUser)
+	if err != nil {
+		return fmt.Errorf("Error deleting user %s of account %d: %s", email, accountID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula delete user JSON response: %s\n", string(responseBody))
+
+	if resp.StatusCode != 200 && resp.StatusCode != 404 {
+		return fmt.Errorf("Error status code %d from Incapsula service when deleting user %s of account %d: %s", resp.StatusCode, email, accountID, string(responseBody))
+	}
+
+	return nil
+}
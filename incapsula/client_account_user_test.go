@@ -0,0 +1,111 @@
+package incapsula
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClientAddAccountUserBadConnection(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+	user, err := client.AddAccountUser(&AccountUserRequest{AccountID: 123, Email: "jane@example.com"})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error adding user jane@example.com to account 123") {
+		t.Errorf("Should have received an client error, got: %s", err)
+	}
+	if user != nil {
+		t.Errorf("Should have received a nil user instance")
+	}
+}
+
+func TestClientAddAccountUserValidUser(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost || req.URL.String() != fmt.Sprintf("/%s", endpointUserManagementUsers) {
+			t.Errorf("Should have have hit POST /%s endpoint. Got: %s %s", endpointUserManagementUsers, req.Method, req.URL.String())
+		}
+		body, _ := ioutil.ReadAll(req.Body)
+		if string(body) != `{"accountId":123,"email":"jane@example.com","firstName":"Jane","lastName":"Doe","roleIds":[42]}` {
+			t.Errorf("Unexpected request body: %s", string(body))
+		}
+		rw.Write([]byte(`{"userId":7,"accountId":123,"email":"jane@example.com","firstName":"Jane","lastName":"Doe","roles":[{"roleId":42,"roleName":"auditor"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	user, err := client.AddAccountUser(&AccountUserRequest{AccountID: 123, Email: "jane@example.com", FirstName: "Jane", LastName: "Doe", RoleIds: []int{42}})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if user == nil || user.UserID != 7 || len(user.Roles) != 1 || user.Roles[0].RoleID != 42 {
+		t.Errorf("Unexpected user: %+v", user)
+	}
+}
+
+func TestClientGetAccountUserNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != fmt.Sprintf("/%s", endpointUserManagementUsers) || req.URL.Query().Get("caid") != "123" || req.URL.Query().Get("email") != "jane@example.com" {
+			t.Errorf("Should have have hit /%s endpoint with caid and email. Got: %s", endpointUserManagementUsers, req.URL.String())
+		}
+		rw.WriteHeader(404)
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	user, err := client.GetAccountUser(123, "jane@example.com")
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if user != nil {
+		t.Errorf("Should have received a nil user instance")
+	}
+}
+
+func TestClientAssignAccountUserRolesValidAssignments(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPost || req.URL.String() != fmt.Sprintf("/%s", endpointUserManagementAssignments) {
+			t.Errorf("Should have have hit POST /%s endpoint. Got: %s %s", endpointUserManagementAssignments, req.Method, req.URL.String())
+		}
+		body, _ := ioutil.ReadAll(req.Body)
+		if string(body) != `[{"accountId":456,"email":"jane@example.com","roleIds":[42]},{"accountId":789,"email":"jane@example.com","roleIds":[]}]` {
+			t.Errorf("Unexpected request body: %s", string(body))
+		}
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.AssignAccountUserRoles([]AccountUserAssignment{
+		{AccountID: 456, Email: "jane@example.com", RoleIds: []int{42}},
+		{AccountID: 789, Email: "jane@example.com", RoleIds: []int{}},
+	})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
+
+func TestClientDeleteAccountUserBadStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(401)
+		rw.Write([]byte(`{"errors":[{"status":"401"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.DeleteAccountUser(123, "jane@example.com")
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error status code 401 from Incapsula service when deleting user jane@example.com of account 123") {
+		t.Errorf("Should have received a bad status error, got: %s", err)
+	}
+}
@@ -0,0 +1,101 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"strings"
+)
+
+const discoveryUrl = "/api-security/discovery/apis/"
+
+type ApiSecurityDiscoveredEndpoint struct {
+	Method    string `json:"method"`
+	Path      string `json:"path"`
+	FirstSeen int64  `json:"firstSeen"`
+}
+
+type ApiSecurityDiscoveredApi struct {
+	Id        int                             `json:"id"`
+	HostName  string                          `json:"hostName"`
+	BasePath  string                          `json:"basePath"`
+	FirstSeen int64                           `json:"firstSeen"`
+	Endpoints []ApiSecurityDiscoveredEndpoint `json:"endpoints"`
+}
+
+type ApiSecurityDiscoveredApisGetResponse struct {
+	Value   []ApiSecurityDiscoveredApi `json:"value"`
+	IsError bool                       `json:"isError"`
+}
+
+// GetApiSecurityDiscoveredApis gets the APIs and endpoints discovered automatically on a site
+func (c *Client) GetApiSecurityDiscoveredApis(siteId int) (*ApiSecurityDiscoveredApisGetResponse, error) {
+	log.Printf("[INFO] Getting Incapsula Api-Security discovered APIs for site ID %d\n", siteId)
+
+	resp, err := c.DoJsonRequestWithHeaders(http.MethodGet, fmt.Sprintf("%s%s%d", c.config.BaseURLAPI, discoveryUrl, siteId), nil, ReadApiSecDiscoveredApis)
+	if err != nil {
+		return nil, fmt.Errorf("Error from Incapsula service when reading Api-Security discovered APIs for site ID %d: %s", siteId, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula Read Api-Security discovered APIs JSON response: %s\n", string(responseBody))
+
+	// Check the response code
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("Error status code %d from Incapsula service when reading Api-Security discovered APIs for site ID %d: %s", resp.StatusCode, siteId, string(responseBody))
+	}
+
+	// Parse the JSON
+	var discoveredApisGetResponse ApiSecurityDiscoveredApisGetResponse
+	err = json.Unmarshal(responseBody, &discoveredApisGetResponse)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing GET Api-Security discovered APIs JSON response for site ID %d: %s\nresponse: %s", siteId, err, string(responseBody))
+	}
+
+	return &discoveredApisGetResponse, nil
+}
+
+// exportApiSecurityDiscoveredApi builds an OAS3 document from a discovered API, which can be used as the
+// api_specification of an incapsula_api_security_api_config
+func exportApiSecurityDiscoveredApi(api *ApiSecurityDiscoveredApi) (string, error) {
+	paths := map[string]interface{}{}
+	for _, endpoint := range api.Endpoints {
+		operations, ok := paths[endpoint.Path].(map[string]interface{})
+		if !ok {
+			operations = map[string]interface{}{}
+			paths[endpoint.Path] = operations
+		}
+		operations[strings.ToLower(endpoint.Method)] = map[string]interface{}{
+			"responses": map[string]interface{}{
+				"default": map[string]interface{}{
+					"description": "Discovered response",
+				},
+			},
+		}
+	}
+
+	document := map[string]interface{}{
+		"openapi": "3.0.1",
+		"info": map[string]interface{}{
+			"title":   fmt.Sprintf("Discovered API %s%s", api.HostName, api.BasePath),
+			"version": "1.0.0",
+		},
+		"servers": []interface{}{
+			map[string]interface{}{"url": fmt.Sprintf("https://%s%s", api.HostName, api.BasePath)},
+		},
+		"paths": paths,
+	}
+
+	specification, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("Error exporting discovered API %d as OpenAPI document: %s", api.Id, err)
+	}
+
+	return string(specification), nil
+}
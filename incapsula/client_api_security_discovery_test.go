@@ -0,0 +1,128 @@
+package incapsula
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+////////////////////////////////////////////////////////////////
+// GetApiSecurityDiscoveredApis Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientGetApiSecurityDiscoveredApisBadConnection(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com", BaseURLRev2: "badness.incapsula.com", BaseURLAPI: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+	siteID := 42
+
+	discoveredApisGetResponse, err := client.GetApiSecurityDiscoveredApis(siteID)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), fmt.Sprintf("Error from Incapsula service when reading Api-Security discovered APIs for site ID %d", siteID)) {
+		t.Errorf("Should have received an client error, got: %s", err)
+	}
+	if discoveredApisGetResponse != nil {
+		t.Errorf("Should have received a nil discoveredApisGetResponse instance")
+	}
+}
+
+func TestClientGetApiSecurityDiscoveredApisBadJSON(t *testing.T) {
+	siteID := 42
+	endpoint := fmt.Sprintf("%s%d", discoveryUrl, siteID)
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		rw.Write([]byte(`{`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL, BaseURLRev2: server.URL, BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	discoveredApisGetResponse, err := client.GetApiSecurityDiscoveredApis(siteID)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), fmt.Sprintf("Error parsing GET Api-Security discovered APIs JSON response for site ID %d", siteID)) {
+		t.Errorf("Should have received a JSON parse error, got: %s", err)
+	}
+	if discoveredApisGetResponse != nil {
+		t.Errorf("Should have received a nil discoveredApisGetResponse instance")
+	}
+}
+
+func TestClientGetApiSecurityDiscoveredApisInvalidSite(t *testing.T) {
+	siteID := 42
+	endpoint := fmt.Sprintf("%s%d", discoveryUrl, siteID)
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(500)
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		rw.Write([]byte(`{"value": "Specified site or account do not exist.", "isError": true}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL, BaseURLRev2: server.URL, BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	discoveredApisGetResponse, err := client.GetApiSecurityDiscoveredApis(siteID)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), fmt.Sprintf("Error status code 500 from Incapsula service when reading Api-Security discovered APIs for site ID %d", siteID)) {
+		t.Errorf("Should have received a status code error, got: %s", err)
+	}
+	if discoveredApisGetResponse != nil {
+		t.Errorf("Should have received a nil discoveredApisGetResponse instance")
+	}
+}
+
+func TestClientGetApiSecurityDiscoveredApisValid(t *testing.T) {
+	siteID := 42
+	endpoint := fmt.Sprintf("%s%d", discoveryUrl, siteID)
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(200)
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		rw.Write([]byte(`{"value": [{"id": 7, "hostName": "api.example.com", "basePath": "/v1", "firstSeen": 1650000000000,
+			"endpoints": [{"method": "GET", "path": "/users", "firstSeen": 1650000000000}, {"method": "POST", "path": "/users", "firstSeen": 1650000001000}]}],
+			"isError": false}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL, BaseURLRev2: server.URL, BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	discoveredApisGetResponse, err := client.GetApiSecurityDiscoveredApis(siteID)
+	if err != nil {
+		t.Fatalf("Should not have received an error : %s", err.Error())
+	}
+	if len(discoveredApisGetResponse.Value) != 1 || len(discoveredApisGetResponse.Value[0].Endpoints) != 2 {
+		t.Fatalf("Should have received 1 API with 2 endpoints, got: %v", discoveredApisGetResponse.Value)
+	}
+
+	specification, err := exportApiSecurityDiscoveredApi(&discoveredApisGetResponse.Value[0])
+	if err != nil {
+		t.Fatalf("Should not have received an error : %s", err.Error())
+	}
+	spec, err := parseApiSpecification(specification)
+	if err != nil {
+		t.Fatalf("Exported specification should be a valid OpenAPI document: %s", err.Error())
+	}
+	if keys := getApiSpecificationEndpointKeys(spec); strings.Join(keys, ",") != "GET /users,POST /users" {
+		t.Errorf("Unexpected exported endpoints: %v", keys)
+	}
+	if host, basePath := getApiSpecificationHostAndBasePath(spec); host != "api.example.com" || basePath != "/v1" {
+		t.Errorf("Unexpected exported host (%s) and base path (%s)", host, basePath)
+	}
+}
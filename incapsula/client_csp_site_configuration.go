@@ -22,10 +22,13 @@ type CSPSiteConfig struct {
 	Settings  struct {
 		Emails []CSPSiteConfigEmail `json:"emails"`
 	} `json:"settings"`
-	TrackingIDs []struct {
-		TrackingId   string `json:"trackingId"`
-		DiscoveredMS int    `json:"discoveredMs"`
-	} `json:"tracking-ids"`
+	TrackingIDs []CSPTrackingID `json:"tracking-ids"`
+}
+
+// CSPTrackingID is an analytics tracking ID discovered on the site
+type CSPTrackingID struct {
+	TrackingId   string `json:"trackingId"`
+	DiscoveredMS int64  `json:"discoveredMs"`
 }
 
 type CSPSiteConfigEmail struct {
@@ -148,7 +148,13 @@ func TestCSPSiteConfigResponse(t *testing.T) {
 						"email": "email@imperva.com"
 					}
 				]
-			}}`))
+			},
+			"tracking-ids": [
+				{
+					"trackingId": "UA-12345-1",
+					"discoveredMs": 1650000000000
+				}
+			]}`))
 	}))
 
 	defer server.Close()
@@ -175,6 +181,9 @@ func TestCSPSiteConfigResponse(t *testing.T) {
 	if ret.Settings.Emails[0].Email != "email@imperva.com" {
 		t.Errorf("Incorrect value inresponse from GetCSPSite")
 	}
+	if len(ret.TrackingIDs) != 1 || ret.TrackingIDs[0].TrackingId != "UA-12345-1" || ret.TrackingIDs[0].DiscoveredMS != 1650000000000 {
+		t.Errorf("Incorrect tracking IDs in response from GetCSPSite: %v", ret.TrackingIDs)
+	}
 
 	ret, err = client.UpdateCSPSite(accountID, siteID, &CSPSiteConfig{})
 	if err != nil {
@@ -21,6 +21,17 @@ type CSPDomainStatus struct {
 	Reviewed *bool `json:"reviewed"`
 }
 
+// CSPDiscoveredDomain is a domain discovered by Client-Side Protection on a site
+type CSPDiscoveredDomain struct {
+	ID            string          `json:"id"`
+	Domain        string          `json:"domain"`
+	Status        CSPDomainStatus `json:"status"`
+	RiskTags      []string        `json:"riskTags"`
+	FirstSeen     int64           `json:"firstSeen"`
+	LastSeen      int64           `json:"lastSeen"`
+	ResourceTypes []string        `json:"resourceTypes"`
+}
+
 type CSPPreApprovedDomain struct {
 	Domain      string `json:"domain"`
 	Subdomains  bool   `json:"subdomains"`
@@ -74,6 +85,50 @@ func (c *Client) getCSPDomainAPI(accountID, siteID int, domain string, APIPath s
 	return nil
 }
 
+func (c *Client) getCSPDomains(accountID, siteID int) ([]CSPDiscoveredDomain, error) {
+	log.Printf("[INFO] Getting CSP discovered domains from site ID: %d\n", siteID)
+
+	var resp *http.Response
+	var err error
+	if accountID != 0 {
+		resp, err = c.DoJsonRequestWithHeaders(http.MethodGet,
+			fmt.Sprintf("%s%s/%d/domains?caid=%d", c.config.BaseURLAPI, CSPSiteApiPath, siteID, accountID),
+			nil,
+			ReadCspSiteDomain)
+	} else {
+		resp, err = c.DoJsonRequestWithHeaders(http.MethodGet,
+			fmt.Sprintf("%s%s/%d/domains", c.config.BaseURLAPI, CSPSiteApiPath, siteID),
+			nil,
+			ReadCspSiteDomain)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error from CSP API for when getting discovered domains from site ID %d: %s\n", siteID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] CSP API get discovered domains JSON response: %s\n", string(responseBody))
+
+	// Check the response code
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("Error status code %d from CSP API when getting discovered domains from site %d: %s\n",
+			resp.StatusCode, siteID, string(responseBody))
+	}
+
+	// Parse the JSON
+	var domains []CSPDiscoveredDomain
+	err = json.Unmarshal([]byte(responseBody), &domains)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing JSON response for discovered domains from site ID %d: %s\nresponse: %s\n",
+			siteID, err, string(responseBody))
+	}
+
+	return domains, nil
+}
+
 func (c *Client) getCSPDomainStatus(accountID, siteID int, domain string) (*CSPDomainStatus, error) {
 	ret := &CSPDomainStatus{}
 	if err := c.getCSPDomainAPI(accountID, siteID, domain, "status", ret); err != nil {
@@ -218,6 +273,50 @@ func (c *Client) deleteCSPDomainNotes(accountID, siteID int, domain string) erro
 	return nil
 }
 
+func (c *Client) getCSPPreApprovedDomains(accountID, siteID int) ([]CSPPreApprovedDomain, error) {
+	log.Printf("[INFO] Getting CSP pre-approved domains list from site ID: %d\n", siteID)
+
+	var resp *http.Response
+	var err error
+	if accountID != 0 {
+		resp, err = c.DoJsonRequestWithHeaders(http.MethodGet,
+			fmt.Sprintf("%s%s/%d/preapprovedlist?caid=%d", c.config.BaseURLAPI, CSPSiteApiPath, siteID, accountID),
+			nil,
+			ReadCspSiteDomain)
+	} else {
+		resp, err = c.DoJsonRequestWithHeaders(http.MethodGet,
+			fmt.Sprintf("%s%s/%d/preapprovedlist", c.config.BaseURLAPI, CSPSiteApiPath, siteID),
+			nil,
+			ReadCspSiteDomain)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error from CSP API for when getting pre-approved domains list for site ID %d: %s\n", siteID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] CSP API Get Pre-Approved Domains List JSON response: %s\n", string(responseBody))
+
+	// Check the response code
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("Error status code %d from CSP API when getting pre-approved domains list for site %d: %s\n",
+			resp.StatusCode, siteID, string(responseBody))
+	}
+
+	// Parse the JSON
+	var preApprovedDomains []CSPPreApprovedDomain
+	err = json.Unmarshal([]byte(responseBody), &preApprovedDomains)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing JSON response for pre-approved domains list for site ID %d: %s\nresponse: %s\n",
+			siteID, err, string(responseBody))
+	}
+
+	return preApprovedDomains, nil
+}
+
 func (c *Client) getCSPPreApprovedDomain(accountID, siteID int, domain string) (*CSPPreApprovedDomain, error) {
 	log.Printf("[INFO] Getting CSP pre-approved domain %s from site ID: %d\n", domain, siteID)
 
@@ -290,3 +290,112 @@ func TestCSPSiteDomainStatusEmptyResponse(t *testing.T) {
 		t.Errorf("Should have received a response")
 	}
 }
+
+func TestCSPSiteDomainsGetResponse(t *testing.T) {
+	apiID := "foo"
+	apiKey := "bar"
+	siteID := 42
+	accountID := 55
+	endpoint := fmt.Sprintf("%s/%d/domains?caid=%d", CSPSiteApiPath, siteID, accountID)
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(200)
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		rw.Write([]byte(`[
+			{
+				"id": "ZG9tYWluLmNvbQ",
+				"domain": "domain.com",
+				"status": {"blocked": false, "reviewed": true},
+				"riskTags": ["NEW_DOMAIN"],
+				"firstSeen": 1650000000000,
+				"lastSeen": 1660000000000,
+				"resourceTypes": ["script", "image"]
+			},
+			{
+				"id": "YmFkLmNvbQ",
+				"domain": "bad.com",
+				"status": {"blocked": true, "reviewed": true}
+			}
+		]`))
+	}))
+
+	defer server.Close()
+
+	config := &Config{APIID: apiID, APIKey: apiKey, BaseURL: server.URL, BaseURLRev2: server.URL, BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	domains, err := client.getCSPDomains(accountID, siteID)
+	if err != nil {
+		t.Errorf("Should have not received an error")
+	}
+	if len(domains) != 2 {
+		t.Fatalf("Should have received 2 domains, got %d", len(domains))
+	}
+	if domains[0].Domain != "domain.com" || *domains[0].Status.Blocked || domains[0].LastSeen != 1660000000000 {
+		t.Errorf("Incorrect value in response from getCSPDomains: %v", domains[0])
+	}
+	if len(domains[0].RiskTags) != 1 || len(domains[0].ResourceTypes) != 2 {
+		t.Errorf("Incorrect risk tags or resource types in response from getCSPDomains: %v", domains[0])
+	}
+	if getCSPDiscoveredDomainStatus(&domains[1]) != cspDomainStatusBlocked {
+		t.Errorf("Expected domain bad.com to be blocked")
+	}
+}
+
+func TestCSPSiteDomainsErrorResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(500)
+		rw.Write([]byte(`Server error`))
+	}))
+
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL, BaseURLRev2: server.URL, BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	domains, err := client.getCSPDomains(0, 42)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error status code 500 from CSP API when getting discovered domains") {
+		t.Errorf("Should have received an client error, got: %s", err)
+	}
+	if domains != nil {
+		t.Errorf("Should have received a nil response")
+	}
+}
+
+func TestCSPSiteDomainPreApprovedListGetResponse(t *testing.T) {
+	siteID := 42
+	accountID := 55
+	endpoint := fmt.Sprintf("%s/%d/preapprovedlist?caid=%d", CSPSiteApiPath, siteID, accountID)
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.WriteHeader(200)
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		rw.Write([]byte(`[
+			{"domain": "domain.com", "subdomains": true, "referenceId": "ZG9tYWluLmNvbQ"},
+			{"domain": "cdn.com", "subdomains": false, "referenceId": "Y2RuLmNvbQ"}
+		]`))
+	}))
+
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL, BaseURLRev2: server.URL, BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	domains, err := client.getCSPPreApprovedDomains(accountID, siteID)
+	if err != nil {
+		t.Errorf("Should have not received an error")
+	}
+	if len(domains) != 2 {
+		t.Fatalf("Should have received 2 domains, got %d", len(domains))
+	}
+	if domains[1].Domain != "cdn.com" || domains[1].Subdomains || domains[1].ReferenceID != "Y2RuLmNvbQ" {
+		t.Errorf("Incorrect value in response from getCSPPreApprovedDomains: %v", domains[1])
+	}
+}
@@ -30,22 +30,32 @@ type RecipientDto struct {
 	DisplayName   string `json:"displayName,omitempty"`
 }
 
-type NotificationChannelEmailDto struct {
-	RecipientToList []RecipientDto `json:"recipientToList"`
+// Notification channel types
+const notificationChannelTypeEmail = "email"
+const notificationChannelTypeWebhook = "webhook"
+
+// NotificationChannelDto is a notification channel, the fields used depend on the channelType:
+// recipientToList for email, url, authHeaderName, authHeaderValue and format for webhook
+type NotificationChannelDto struct {
 	ChannelType     string         `json:"channelType"`
+	RecipientToList []RecipientDto `json:"recipientToList,omitempty"`
+	Url             string         `json:"url,omitempty"`
+	AuthHeaderName  string         `json:"authHeaderName,omitempty"`
+	AuthHeaderValue string         `json:"authHeaderValue,omitempty"`
+	Format          string         `json:"format,omitempty"`
 }
 
 type NotificationPolicyFullDto struct {
-	PolicyId                int                           `json:"policyId,omitempty"`
-	AccountId               int                           `json:"accountId"`
-	PolicyName              string                        `json:"policyName"`
-	Status                  string                        `json:"status"`
-	SubCategory             string                        `json:"subCategory"`
-	NotificationChannelList []NotificationChannelEmailDto `json:"notificationChannelList"`
-	AssetList               []AssetDto                    `json:"assetList"`
-	ApplyToNewAssets        string                        `json:"applyToNewAssets"`
-	PolicyType              string                        `json:"policyType"`
-	SubAccountPolicyInfo    SubAccountPolicyInfo          `json:"subAccountPolicyInfo"`
+	PolicyId                int                      `json:"policyId,omitempty"`
+	AccountId               int                      `json:"accountId"`
+	PolicyName              string                   `json:"policyName"`
+	Status                  string                   `json:"status"`
+	SubCategory             string                   `json:"subCategory"`
+	NotificationChannelList []NotificationChannelDto `json:"notificationChannelList"`
+	AssetList               []AssetDto               `json:"assetList"`
+	ApplyToNewAssets        string                   `json:"applyToNewAssets"`
+	PolicyType              string                   `json:"policyType"`
+	SubAccountPolicyInfo    SubAccountPolicyInfo     `json:"subAccountPolicyInfo"`
 }
 
 type NotificationPolicy struct {
@@ -1,7 +1,9 @@
 package incapsula
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -135,3 +137,57 @@ func TestAddNotificationCenterPolicyValidPolicy(t *testing.T) {
 		t.Errorf("Should not have received an empty policy Id")
 	}
 }
+
+func TestAddNotificationCenterPolicyWebhookChannel(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		requestBody, _ := ioutil.ReadAll(req.Body)
+		var request NotificationPolicy
+		if err := json.Unmarshal(requestBody, &request); err != nil {
+			t.Errorf("Should have sent a valid JSON request, got: %s", string(requestBody))
+		}
+		if len(request.Data.NotificationChannelList) != 1 || request.Data.NotificationChannelList[0].Url != "https://hooks.example.com/incapsula" {
+			t.Errorf("Should have sent the webhook channel, got: %s", string(requestBody))
+		}
+		if strings.Contains(string(requestBody), "recipientToList") {
+			t.Errorf("Should not have sent recipients for a webhook channel, got: %s", string(requestBody))
+		}
+		rw.Write([]byte(`
+			{
+			    "data":
+			    {
+			        "accountId": 1234,
+			        "policyId": 888,
+			        "notificationChannelList":
+			        [
+			            {
+			                "channelType": "webhook",
+			                "url": "https://hooks.example.com/incapsula",
+			                "authHeaderName": "Authorization",
+			                "format": "JSON"
+			            }
+			        ]
+			    }
+			}
+			`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL, BaseURLRev2: server.URL, BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	policy := notificationPolicyFullDto
+	policy.NotificationChannelList = []NotificationChannelDto{{
+		ChannelType:     notificationChannelTypeWebhook,
+		Url:             "https://hooks.example.com/incapsula",
+		AuthHeaderName:  "Authorization",
+		AuthHeaderValue: "Bearer secret",
+		Format:          "JSON",
+	}}
+	addNotificationPolicyResponse, err := client.AddNotificationCenterPolicy(&policy)
+	if err != nil {
+		t.Errorf("Should not have received an error, the error: %s", err)
+	}
+	channels := addNotificationPolicyResponse.Data.NotificationChannelList
+	if len(channels) != 1 || channels[0].ChannelType != notificationChannelTypeWebhook || channels[0].AuthHeaderName != "Authorization" {
+		t.Errorf("Should have received the webhook channel, got: %+v", channels)
+	}
+}
@@ -0,0 +1,50 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+)
+
+const endPointNotificationCenterSubCategories = "notification-settings/v3/sub-categories"
+
+// NotificationSubCategoryDto describes a notification sub category and the asset types it supports
+type NotificationSubCategoryDto struct {
+	SubCategory string   `json:"subCategory"`
+	DisplayName string   `json:"displayName"`
+	Category    string   `json:"category"`
+	AssetTypes  []string `json:"assetTypes"`
+}
+
+type NotificationSubCategories struct {
+	Data []NotificationSubCategoryDto `json:"data"`
+}
+
+// GetNotificationCenterSubCategories gets the notification sub categories available to the account
+func (c *Client) GetNotificationCenterSubCategories(accountId int) (*NotificationSubCategories, error) {
+	log.Printf("[INFO] Getting NotificationCenter sub categories for accountId: %d", accountId)
+	requestUrl := fmt.Sprintf("%s/%s", c.config.BaseURLAPI, endPointNotificationCenterSubCategories)
+
+	params := GetRequestParamsWithCaid(accountId)
+	resp, err := c.DoJsonAndQueryParamsRequestWithHeaders(http.MethodGet, requestUrl, nil, params, ReadNotificationCenterSubCategories)
+	if err != nil {
+		return nil, fmt.Errorf("Error from NotificationCenter service when reading sub categories: %s ", err)
+	}
+
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+	log.Printf("[DEBUG] NotificationCenter Read sub categories JSON response: %s\n", string(responseBody))
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("Error status code %d from NotificationCenter service when reading sub categories: %s ", resp.StatusCode, string(responseBody))
+	}
+
+	var subCategories NotificationSubCategories
+	err = json.Unmarshal(responseBody, &subCategories)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing NotificationCenter sub categories JSON response: %s\nresponse: %s", err, string(responseBody))
+	}
+
+	return &subCategories, nil
+}
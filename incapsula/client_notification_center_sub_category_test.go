@@ -0,0 +1,77 @@
+package incapsula
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClientGetNotificationCenterSubCategoriesBadConnection(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+	subCategories, err := client.GetNotificationCenterSubCategories(1234)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error from NotificationCenter service when reading sub categories") {
+		t.Errorf("Should have received an client error, got: %s", err)
+	}
+	if subCategories != nil {
+		t.Errorf("Should have received a nil response")
+	}
+}
+
+func TestClientGetNotificationCenterSubCategoriesBadJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"data": [`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL, BaseURLRev2: server.URL, BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	subCategories, err := client.GetNotificationCenterSubCategories(1234)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error parsing NotificationCenter sub categories JSON response") {
+		t.Errorf("Should have received a JSON parse error, got: %s", err)
+	}
+	if subCategories != nil {
+		t.Errorf("Should have received a nil response")
+	}
+}
+
+func TestClientGetNotificationCenterSubCategoriesValid(t *testing.T) {
+	endpoint := fmt.Sprintf("/%s?caid=1234", endPointNotificationCenterSubCategories)
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != endpoint {
+			t.Errorf("Should have have hit %s endpoint. Got: %s", endpoint, req.URL.String())
+		}
+		rw.Write([]byte(`
+			{
+			    "data":
+			    [
+			        {"subCategory": "ACCOUNT_NOTIFICATIONS", "displayName": "Account notifications", "category": "ACCOUNT", "assetTypes": []},
+			        {"subCategory": "SITE_NOTIFICATIONS", "displayName": "Website notifications", "category": "WEBSITE", "assetTypes": ["SITE"]}
+			    ]
+			}
+			`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL, BaseURLRev2: server.URL, BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	subCategories, err := client.GetNotificationCenterSubCategories(1234)
+	if err != nil {
+		t.Errorf("Should not have received an error, the error: %s", err)
+	}
+	if len(subCategories.Data) != 2 {
+		t.Fatalf("Should have received 2 sub categories, got: %+v", subCategories.Data)
+	}
+	if subCategories.Data[1].SubCategory != "SITE_NOTIFICATIONS" || len(subCategories.Data[1].AssetTypes) != 1 || subCategories.Data[1].AssetTypes[0] != "SITE" {
+		t.Errorf("Incorrect sub category in response: %+v", subCategories.Data[1])
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"io/ioutil"
 	"log"
 	"net/http"
+	"net/url"
+	"strconv"
 )
 
 // SetOriginPOPResponse contains the relevant site information when setting an Incapsula Origin POP
@@ -19,10 +21,11 @@ type SetOriginPOPResponse struct {
 
 // SetOriginPOP sets the origin POP for given data center
 func (c *Client) SetOriginPOP(dcID int, originPOP string) error {
-	reqURL := fmt.Sprintf("%s/sites/datacenter/origin-pop/modify?dc_id=%d", c.config.BaseURL, dcID)
+	values := url.Values{"dc_id": {strconv.Itoa(dcID)}}
 	if originPOP != "" {
-		reqURL = fmt.Sprintf("%s&origin_pop=%s", reqURL, originPOP)
+		values.Set("origin_pop", originPOP)
 	}
+	reqURL := fmt.Sprintf("%s/sites/datacenter/origin-pop/modify?%s", c.config.BaseURL, values.Encode())
 	// Post request to Incapsula
 	resp, err := c.DoJsonRequestWithHeaders(http.MethodPost, reqURL, nil, UpdateOriginPop)
 	if err != nil {
@@ -50,3 +53,52 @@ func (c *Client) SetOriginPOP(dcID int, originPOP string) error {
 
 	return nil
 }
+
+// Pop is an Imperva PoP, the region is one of the data centers geo_locations
+type Pop struct {
+	Code              string  `json:"code"`
+	City              string  `json:"city"`
+	Country           string  `json:"country"`
+	Region            string  `json:"region"`
+	Latitude          float64 `json:"latitude"`
+	Longitude         float64 `json:"longitude"`
+	SupportsOriginPop bool    `json:"supportsOriginPop"`
+}
+
+// PopsDTO contains the list of the Imperva PoPs
+type PopsDTO struct {
+	Errors []ApiError `json:"errors"`
+	Data   []Pop      `json:"data"`
+}
+
+// GetPops gets the list of the Imperva PoPs
+func (c *Client) GetPops() (*PopsDTO, error) {
+	log.Printf("[INFO] Getting Incapsula PoPs\n")
+
+	baseURLv3 := c.config.BaseURL[:len(c.config.BaseURL)-3] + "/v3"
+	reqURL := fmt.Sprintf("%s/pops", baseURLv3)
+	resp, err := c.DoJsonRequestWithHeaders(http.MethodGet, reqURL, nil, ReadPops)
+	if err != nil {
+		return nil, fmt.Errorf("Error executing get PoPs request: %s", err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula PoPs JSON response: %s\n", string(responseBody))
+
+	// Parse the JSON
+	var responseDTO PopsDTO
+	err = json.Unmarshal([]byte(responseBody), &responseDTO)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing PoPs JSON response: %s\nresponse: %s", err, string(responseBody))
+	}
+
+	if len(responseDTO.Errors) > 0 {
+		return nil, fmt.Errorf("Error from Incapsula service when getting PoPs: %s", string(responseBody))
+	}
+
+	return &responseDTO, nil
+}
@@ -0,0 +1,116 @@
+package incapsula
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+////////////////////////////////////////////////////////////////
+// SetOriginPOP Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientSetOriginPOPQueryParams(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/api/prov/v1/sites/datacenter/origin-pop/modify" {
+			t.Errorf("Should have have hit /api/prov/v1/sites/datacenter/origin-pop/modify endpoint. Got: %s", req.URL.Path)
+		}
+		if req.URL.Query().Get("dc_id") != "42" || req.URL.Query().Get("origin_pop") != "a&b" {
+			t.Errorf("Should have sent escaped query params. Got: %s", req.URL.RawQuery)
+		}
+		rw.Write([]byte(`{"res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL + "/api/prov/v1"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.SetOriginPOP(42, "a&b")
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// GetPops Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientGetPopsBadConnection(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+	popsDTO, err := client.GetPops()
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error executing get PoPs request") {
+		t.Errorf("Should have received an client error, got: %s", err)
+	}
+	if popsDTO != nil {
+		t.Errorf("Should have received a nil popsDTO instance")
+	}
+}
+
+func TestClientGetPopsBadJSON(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != "/api/prov/v3/pops" {
+			t.Errorf("Should have have hit /api/prov/v3/pops endpoint. Got: %s", req.URL.String())
+		}
+		rw.Write([]byte(`{`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL + "/api/prov/v1"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	popsDTO, err := client.GetPops()
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error parsing PoPs JSON response") {
+		t.Errorf("Should have received a JSON parse error, got: %s", err)
+	}
+	if popsDTO != nil {
+		t.Errorf("Should have received a nil popsDTO instance")
+	}
+}
+
+func TestClientGetPopsErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"errors":[{"status": "401"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL + "/api/prov/v1"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	popsDTO, err := client.GetPops()
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error from Incapsula service when getting PoPs") {
+		t.Errorf("Should have received a service error, got: %s", err)
+	}
+	if popsDTO != nil {
+		t.Errorf("Should have received a nil popsDTO instance")
+	}
+}
+
+func TestClientGetPopsValid(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"data":[{"code":"lax","city":"Los Angeles","country":"US","region":"US_WEST","latitude":34.05,"longitude":-118.24,"supportsOriginPop":true}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL + "/api/prov/v1"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	popsDTO, err := client.GetPops()
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if popsDTO == nil || len(popsDTO.Data) != 1 {
+		t.Fatalf("Should have received one PoP, got: %+v", popsDTO)
+	}
+	if pop := popsDTO.Data[0]; fmt.Sprintf("%s %s %s %t", pop.Code, pop.City, pop.Region, pop.SupportsOriginPop) != "lax Los Angeles US_WEST true" {
+		t.Errorf("Unexpected PoP: %+v", pop)
+	}
+}
@@ -6,7 +6,9 @@ import (
 	"io/ioutil"
 	"log"
 	"net/url"
+	"sort"
 	"strconv"
+	"strings"
 )
 
 // Endpoints (unexported consts)
@@ -17,17 +19,35 @@ const endpointExceptionList = "sites/status"
 // NOTE: no exceptions for whitelistedIPsExceptionRuleId
 var securityRuleExceptionParamMapping = map[string][]string{
 	// ACL RuleIDs
-	blacklistedCountriesExceptionRuleID: {"client_app_types", "ips", "url_patterns", "urls"},
-	blacklistedIPsExceptionRuleID:       {"client_apps", "countries", "continents", "ips", "url_patterns", "urls"},
-	blacklistedURLsExceptionRuleID:      {"client_apps", "countries", "continents", "ips", "url_patterns", "urls"},
+	blacklistedCountriesExceptionRuleID: {"client_app_types", "ips", "urls"},
+	blacklistedIPsExceptionRuleID:       {"client_apps", "countries", "continents", "ips", "urls"},
+	blacklistedURLsExceptionRuleID:      {"client_apps", "countries", "continents", "ips", "urls"},
 	// WAF RuleIDs
-	backdoorExceptionRuleID:              {"client_apps", "countries", "continents", "ips", "url_patterns", "urls", "user_agents", "parameters"},
-	botAccessControlExceptionRuleID:      {"client_app_types", "ips", "url_patterns", "urls", "user_agents"},
-	crossSiteScriptingExceptionRuleID:    {"client_apps", "countries", "continents", "url_patterns", "urls", "parameters"},
-	ddosExceptionRuleID:                  {"client_apps", "countries", "continents", "ips", "url_patterns", "urls"},
-	illegalResourceAccessExceptionRuleID: {"client_apps", "countries", "continents", "ips", "url_patterns", "urls", "parameters"},
-	remoteFileInclusionExceptionRuleID:   {"client_apps", "countries", "continents", "ips", "url_patterns", "urls", "user_agents", "parameters"},
-	sqlInjectionExceptionRuleID:          {"client_apps", "countries", "continents", "ips", "url_patterns", "urls", "parameters"},
+	backdoorExceptionRuleID:              {"client_apps", "countries", "continents", "ips", "urls", "user_agents", "parameters"},
+	botAccessControlExceptionRuleID:      {"client_app_types", "ips", "urls", "user_agents"},
+	crossSiteScriptingExceptionRuleID:    {"client_apps", "countries", "continents", "urls", "parameters"},
+	ddosExceptionRuleID:                  {"client_apps", "countries", "continents", "ips", "urls"},
+	illegalResourceAccessExceptionRuleID: {"client_apps", "countries", "continents", "ips", "urls", "parameters"},
+	remoteFileInclusionExceptionRuleID:   {"client_apps", "countries", "continents", "ips", "urls", "user_agents", "parameters"},
+	sqlInjectionExceptionRuleID:          {"client_apps", "countries", "continents", "ips", "urls", "parameters"},
+}
+
+// SecurityRuleExceptionURL is a resource path and its matching pattern
+type SecurityRuleExceptionURL struct {
+	URL     string
+	Pattern string
+}
+
+// SecurityRuleException contains the values of a security rule exception
+type SecurityRuleException struct {
+	ClientAppTypes []string
+	ClientApps     []string
+	Countries      []string
+	Continents     []string
+	Ips            []string
+	Urls           []SecurityRuleExceptionURL
+	UserAgents     []string
+	Parameters     []string
 }
 
 // SecurityRuleExceptionCreateResponse provides exception_id of rule exception
@@ -37,8 +57,86 @@ type SecurityRuleExceptionCreateResponse struct {
 	Status      string `json:"status"`
 }
 
+// fields returns the exception params which have a value, keyed by param name
+func (exception *SecurityRuleException) fields() map[string][]string {
+	fields := map[string][]string{
+		"client_app_types": exception.ClientAppTypes,
+		"client_apps":      exception.ClientApps,
+		"countries":        exception.Countries,
+		"continents":       exception.Continents,
+		"ips":              exception.Ips,
+		"user_agents":      exception.UserAgents,
+		"parameters":       exception.Parameters,
+	}
+	if len(exception.Urls) > 0 {
+		fields["urls"] = make([]string, 0, len(exception.Urls))
+		for _, exceptionURL := range exception.Urls {
+			fields["urls"] = append(fields["urls"], exceptionURL.URL)
+		}
+	}
+
+	for param, values := range fields {
+		if len(values) == 0 {
+			delete(fields, param)
+		}
+	}
+
+	return fields
+}
+
+// validateSecurityRuleExceptionParams checks that the rule ID is known and supports all the given params
+func validateSecurityRuleExceptionParams(ruleID string, params []string) error {
+	ruleParams, ok := securityRuleExceptionParamMapping[ruleID]
+	if !ok {
+		return fmt.Errorf("Error configuring security rule exception: invalid rule_id (%s)", ruleID)
+	}
+
+	sort.Strings(params)
+	for _, param := range params {
+		supported := false
+		for _, ruleParam := range ruleParams {
+			if param == ruleParam {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return fmt.Errorf("Error configuring security rule exception: %s is not supported for rule_id (%s), supported values are: %s", param, ruleID, strings.Join(ruleParams, ", "))
+		}
+	}
+
+	return nil
+}
+
+// securityRuleExceptionValues builds the request values of the exception, validating them against the rule ID
+func securityRuleExceptionValues(values url.Values, ruleID string, exception *SecurityRuleException) error {
+	fields := exception.fields()
+
+	params := make([]string, 0, len(fields))
+	for param := range fields {
+		params = append(params, param)
+	}
+	if err := validateSecurityRuleExceptionParams(ruleID, params); err != nil {
+		return err
+	}
+
+	for param, paramValues := range fields {
+		values.Add(param, strings.Join(paramValues, ","))
+	}
+
+	if len(exception.Urls) > 0 {
+		urlPatterns := make([]string, 0, len(exception.Urls))
+		for _, exceptionURL := range exception.Urls {
+			urlPatterns = append(urlPatterns, exceptionURL.Pattern)
+		}
+		values.Add("url_patterns", strings.Join(urlPatterns, ","))
+	}
+
+	return nil
+}
+
 // AddSecurityRuleException adds a security rule exception
-func (c *Client) AddSecurityRuleException(siteID int, ruleID, clientAppTypes, clientApps, countries, continents, ips, urlPatterns, urls, userAgents, parameters string) (*SecurityRuleExceptionCreateResponse, error) {
+func (c *Client) AddSecurityRuleException(siteID int, ruleID string, exception *SecurityRuleException) (*SecurityRuleExceptionCreateResponse, error) {
 	// Base URL values
 	values := url.Values{
 		"site_id":           {strconv.Itoa(siteID)},
@@ -48,33 +146,9 @@ func (c *Client) AddSecurityRuleException(siteID int, ruleID, clientAppTypes, cl
 
 	log.Printf("[INFO] Adding new security rule exception for rule_id (%s) for site id (%d)\n", ruleID, siteID)
 
-	// Check to see if ruleID is correct, then iterate rule specific parameters
-	if ruleParams, ok := securityRuleExceptionParamMapping[ruleID]; ok {
-		for i := 0; i < len(ruleParams); i++ {
-			// Add param values for specific ruleID based on securityRuleExceptionParamMapping
-			param := ruleParams[i]
-			if param == "client_app_types" && clientAppTypes != "" {
-				values.Add("client_app_types", clientAppTypes)
-			} else if param == "client_apps" && clientApps != "" {
-				values.Add("client_apps", clientApps)
-			} else if param == "countries" && countries != "" {
-				values.Add("countries", countries)
-			} else if param == "continents" && continents != "" {
-				values.Add("continents", continents)
-			} else if param == "ips" && ips != "" {
-				values.Add("ips", ips)
-			} else if param == "parameters" && parameters != "" {
-				values.Add("parameters", parameters)
-			} else if param == "url_patterns" && urlPatterns != "" {
-				values.Add("url_patterns", urlPatterns)
-			} else if param == "urls" && urls != "" {
-				values.Add("urls", urls)
-			} else if param == "user_agents" && userAgents != "" {
-				values.Add("user_agents", userAgents)
-			}
-		}
-	} else {
-		return nil, fmt.Errorf("Error configuring security rule exception: invalid rule_id (%s)", ruleID)
+	// Check to see if ruleID is correct and supports the exception params
+	if err := securityRuleExceptionValues(values, ruleID, exception); err != nil {
+		return nil, err
 	}
 
 	// Post form to Incapsula
@@ -107,7 +181,7 @@ func (c *Client) AddSecurityRuleException(siteID int, ruleID, clientAppTypes, cl
 }
 
 // EditSecurityRuleException edits a security rule exception
-func (c *Client) EditSecurityRuleException(siteID int, ruleID, clientAppTypes, clientApps, countries, continents, ips, urlPatterns, urls, userAgents, parameters, whitelistID string) (*SiteStatusResponse, error) {
+func (c *Client) EditSecurityRuleException(siteID int, ruleID string, exception *SecurityRuleException, whitelistID string) (*SiteStatusResponse, error) {
 	// Base URL values
 	values := url.Values{
 		"site_id":      {strconv.Itoa(siteID)},
@@ -117,33 +191,9 @@ func (c *Client) EditSecurityRuleException(siteID int, ruleID, clientAppTypes, c
 
 	log.Printf("[INFO] Updating existing security rule exception for rule_id (%s) whitelist_id (%s) for site_id (%d)\n", ruleID, whitelistID, siteID)
 
-	// Check to see if ruleID is correct, then iterate rule specific parameters
-	if ruleParams, ok := securityRuleExceptionParamMapping[ruleID]; ok {
-		for i := 0; i < len(ruleParams); i++ {
-			// Add param values for specific ruleID based on securityRuleExceptionParamMapping
-			param := ruleParams[i]
-			if param == "client_app_types" && clientAppTypes != "" {
-				values.Add("client_app_types", clientAppTypes)
-			} else if param == "client_apps" && clientApps != "" {
-				values.Add("client_apps", clientApps)
-			} else if param == "countries" && countries != "" {
-				values.Add("countries", countries)
-			} else if param == "continents" && continents != "" {
-				values.Add("continents", continents)
-			} else if param == "ips" && ips != "" {
-				values.Add("ips", ips)
-			} else if param == "parameters" && parameters != "" {
-				values.Add("parameters", parameters)
-			} else if param == "url_patterns" && urlPatterns != "" {
-				values.Add("url_patterns", urlPatterns)
-			} else if param == "urls" && urls != "" {
-				values.Add("urls", urls)
-			} else if param == "user_agents" && userAgents != "" {
-				values.Add("user_agents", userAgents)
-			}
-		}
-	} else {
-		return nil, fmt.Errorf("Error configuring security rule exception: invalid rule_id (%s)", ruleID)
+	// Check to see if ruleID is correct and supports the exception params
+	if err := securityRuleExceptionValues(values, ruleID, exception); err != nil {
+		return nil, err
 	}
 
 	// Post form to Incapsula
@@ -167,8 +217,18 @@ func (c *Client) EditSecurityRuleException(siteID int, ruleID, clientAppTypes, c
 		return nil, fmt.Errorf("Error parsing configure security rule exception JSON response for rule_id (%s) and site_id (%d)", ruleID, siteID)
 	}
 
+	// Res can sometimes oscillate between a string and number
+	// We need to add safeguards for this inside the provider
+	var resString string
+
+	if resNumber, ok := siteStatusResponse.Res.(float64); ok {
+		resString = fmt.Sprintf("%d", int(resNumber))
+	} else {
+		resString, _ = siteStatusResponse.Res.(string)
+	}
+
 	// Look at the response status code from Incapsula
-	if siteStatusResponse.Res != 0 {
+	if resString != "0" {
 		return nil, fmt.Errorf("Error from Incapsula service when adding security rule exception for rule_id (%s) and site_id (%d): %s", ruleID, siteID, string(responseBody))
 	}
 
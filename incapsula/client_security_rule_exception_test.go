@@ -21,7 +21,7 @@ func TestClientAddSecurityRuleExceptionBadConnection(t *testing.T) {
 	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
 	siteID := 1234
 	ruleID := "api.threats.backdoor"
-	addSecurityRuleExceptionResponse, err := client.AddSecurityRuleException(siteID, ruleID, "", "", "", "", "", "", "", "", "")
+	addSecurityRuleExceptionResponse, err := client.AddSecurityRuleException(siteID, ruleID, &SecurityRuleException{})
 	if err == nil {
 		t.Errorf("Should have received an error")
 	}
@@ -48,7 +48,7 @@ func TestClientAddSecurityRuleExceptionBadJSON(t *testing.T) {
 	client := &Client{config: config, httpClient: &http.Client{}}
 	siteID := 1234
 	ruleID := "api.threats.backdoor"
-	addSecurityRuleExceptionResponse, err := client.AddSecurityRuleException(siteID, ruleID, "", "", "", "", "", "", "", "", "")
+	addSecurityRuleExceptionResponse, err := client.AddSecurityRuleException(siteID, ruleID, &SecurityRuleException{})
 	if err == nil {
 		t.Errorf("Should have received an error")
 	}
@@ -75,7 +75,7 @@ func TestClientAddSecurityRuleExceptionInvalidRuleID(t *testing.T) {
 	client := &Client{config: config, httpClient: &http.Client{}}
 	siteID := 1234
 	ruleID := "bad_rule_id"
-	addSecurityRuleExceptionResponse, err := client.AddSecurityRuleException(siteID, ruleID, "", "", "", "AN,AS", "", "", "", "", "")
+	addSecurityRuleExceptionResponse, err := client.AddSecurityRuleException(siteID, ruleID, &SecurityRuleException{Continents: []string{"AN", "AS"}})
 	if err == nil {
 		t.Errorf("Should have received an error")
 	}
@@ -103,7 +103,7 @@ func TestClientAddSecurityRuleExceptionInvalidParam(t *testing.T) {
 	siteID := 1234
 	ruleID := "api.threats.backdoor"
 	badIps := "1234"
-	addSecurityRuleExceptionResponse, err := client.AddSecurityRuleException(siteID, ruleID, "", "", "", "", badIps, "", "", "", "")
+	addSecurityRuleExceptionResponse, err := client.AddSecurityRuleException(siteID, ruleID, &SecurityRuleException{Ips: []string{badIps}})
 	if err == nil {
 		t.Errorf("Should have received an error")
 	}
@@ -115,6 +115,69 @@ func TestClientAddSecurityRuleExceptionInvalidParam(t *testing.T) {
 	}
 }
 
+func TestClientAddSecurityRuleExceptionUnsupportedParam(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[DEBUG] Running test client_security_rule_exception.TestClientAddSecurityRuleExceptionUnsupportedParam")
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		t.Errorf("Should not have hit the Incapsula service. Got: %s", req.URL.String())
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	siteID := 1234
+	ruleID := crossSiteScriptingExceptionRuleID
+	addSecurityRuleExceptionResponse, err := client.AddSecurityRuleException(siteID, ruleID, &SecurityRuleException{Ips: []string{"1.2.3.4"}})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), fmt.Sprintf("Error configuring security rule exception: ips is not supported for rule_id (%s)", ruleID)) {
+		t.Errorf("Should have received an unsupported param error, got: %s", err)
+	}
+	if addSecurityRuleExceptionResponse != nil {
+		t.Errorf("Should have received a nil addSecurityRuleExceptionResponse instance")
+	}
+}
+
+func TestClientAddSecurityRuleExceptionValidRule(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[DEBUG] Running test client_security_rule_exception.TestClientAddSecurityRuleExceptionValidRule")
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointExceptionConfigure) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointExceptionConfigure, req.URL.String())
+		}
+		req.ParseForm()
+		if req.PostForm.Get("urls") != "/admin,/login" || req.PostForm.Get("url_patterns") != "PREFIX,EQUALS" {
+			t.Errorf("Should have sent the urls with their patterns. Got: %s / %s", req.PostForm.Get("urls"), req.PostForm.Get("url_patterns"))
+		}
+		if req.PostForm.Get("countries") != "FR,IL" {
+			t.Errorf("Should have sent comma separated countries. Got: %s", req.PostForm.Get("countries"))
+		}
+		if _, ok := req.PostForm["ips"]; ok {
+			t.Errorf("Should not have sent empty ips")
+		}
+		rw.Write([]byte(`{"res":"0","exception_id":"4321","status":"ok"}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	exception := SecurityRuleException{
+		Countries: []string{"FR", "IL"},
+		Urls: []SecurityRuleExceptionURL{
+			{URL: "/admin", Pattern: "PREFIX"},
+			{URL: "/login", Pattern: "EQUALS"},
+		},
+	}
+	addSecurityRuleExceptionResponse, err := client.AddSecurityRuleException(1234, sqlInjectionExceptionRuleID, &exception)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if addSecurityRuleExceptionResponse == nil || addSecurityRuleExceptionResponse.ExceptionID != "4321" {
+		t.Errorf("Should have received exception ID 4321")
+	}
+}
+
 ////////////////////////////////////////////////////////////////
 // EditSecurityRuleException Tests
 ////////////////////////////////////////////////////////////////
@@ -126,7 +189,7 @@ func TestClientEditSecurityRuleExceptionBadConnection(t *testing.T) {
 	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
 	siteID := 1234
 	ruleID := "api.threats.backdoor"
-	editSecurityRuleExceptionResponse, err := client.EditSecurityRuleException(siteID, ruleID, "", "", "", "", "", "", "", "", "", "")
+	editSecurityRuleExceptionResponse, err := client.EditSecurityRuleException(siteID, ruleID, &SecurityRuleException{}, "")
 	if err == nil {
 		t.Errorf("Should have received an error")
 	}
@@ -153,7 +216,7 @@ func TestClientEditecurityRuleExceptionBadJSON(t *testing.T) {
 	client := &Client{config: config, httpClient: &http.Client{}}
 	siteID := 1234
 	ruleID := "api.threats.backdoor"
-	editSecurityRuleExceptionResponse, err := client.EditSecurityRuleException(siteID, ruleID, "", "", "", "", "", "", "", "", "", "")
+	editSecurityRuleExceptionResponse, err := client.EditSecurityRuleException(siteID, ruleID, &SecurityRuleException{}, "")
 	if err == nil {
 		t.Errorf("Should have received an error")
 	}
@@ -180,7 +243,7 @@ func TestClientEditSecurityRuleExceptionInvalidRuleID(t *testing.T) {
 	client := &Client{config: config, httpClient: &http.Client{}}
 	siteID := 1234
 	ruleID := "bad_rule_id"
-	editSecurityRuleExceptionResponse, err := client.EditSecurityRuleException(siteID, ruleID, "", "", "", "", "", "", "", "", "", "")
+	editSecurityRuleExceptionResponse, err := client.EditSecurityRuleException(siteID, ruleID, &SecurityRuleException{}, "")
 	if err == nil {
 		t.Errorf("Should have received an error")
 	}
@@ -209,7 +272,7 @@ func TestClientEditSecurityRuleExceptionInvalidWhitelistID(t *testing.T) {
 	ruleID := "api.threats.backdoor"
 	badIps := "1.2.3.4,1.2.4"
 	badWhitelistID := "1234"
-	editSecurityRuleExceptionResponse, err := client.EditSecurityRuleException(siteID, ruleID, "", "", "", "", badIps, "", "", "", "", badWhitelistID)
+	editSecurityRuleExceptionResponse, err := client.EditSecurityRuleException(siteID, ruleID, &SecurityRuleException{Ips: []string{badIps}}, badWhitelistID)
 	if err == nil {
 		t.Errorf("Should have received an error")
 	}
@@ -237,7 +300,7 @@ func TestClientEditSecurityRuleExceptionInvalidParam(t *testing.T) {
 	siteID := 1234
 	ruleID := "api.threats.backdoor"
 	badIps := "1234"
-	editSecurityRuleExceptionResponse, err := client.EditSecurityRuleException(siteID, ruleID, "", "", "", "", badIps, "", "", "", "", "")
+	editSecurityRuleExceptionResponse, err := client.EditSecurityRuleException(siteID, ruleID, &SecurityRuleException{Ips: []string{badIps}}, "")
 	if err == nil {
 		t.Errorf("Should have received an error")
 	}
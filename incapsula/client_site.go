@@ -33,6 +33,31 @@ type SiteStatusDNSValidationData struct {
 	SetDataTo     []string `json:"set_data_to"`
 }
 
+// SiteStatusRuleException is an exception of a WAF or ACL rule as returned in the site status
+type SiteStatusRuleException struct {
+	Values []SiteStatusRuleExceptionValue `json:"values,omitempty"`
+	ID     int                            `json:"id,omitempty"`
+}
+
+// SiteStatusRuleExceptionValue is a single exception type (and its values) of a rule exception
+type SiteStatusRuleExceptionValue struct {
+	ID   string   `json:"id,omitempty"`
+	Name string   `json:"name,omitempty"`
+	Ips  []string `json:"ips,omitempty"`
+	Urls []struct {
+		Value   string `json:"value,omitempty"`
+		Pattern string `json:"pattern,omitempty"`
+	} `json:"urls,omitempty"`
+	Geo struct {
+		Countries  []string `json:"countries,omitempty"`
+		Continents []string `json:"continents,omitempty"`
+	} `json:"geo,omitempty"`
+	ClientApps     []string `json:"client_apps,omitempty"`
+	ClientAppTypes []string `json:"client_app_types,omitempty"`
+	Parameters     []string `json:"parameters,omitempty"`
+	UserAgents     []string `json:"user_agents,omitempty"`
+}
+
 // SiteStatusResponse contains managed site information
 type SiteStatusResponse struct {
 	SiteID               int      `json:"site_id"`
@@ -65,35 +90,16 @@ type SiteStatusResponse struct {
 	Security                             struct {
 		Waf struct {
 			Rules []struct {
-				Action                 string `json:"action,omitempty"`
-				ActionText             string `json:"action_text,omitempty"`
-				ID                     string `json:"id"`
-				Name                   string `json:"name"`
-				BlockBadBots           bool   `json:"block_bad_bots,omitempty"`
-				ChallengeSuspectedBots bool   `json:"challenge_suspected_bots,omitempty"`
-				ActivationMode         string `json:"activation_mode,omitempty"`
-				ActivationModeText     string `json:"activation_mode_text,omitempty"`
-				DdosTrafficThreshold   int    `json:"ddos_traffic_threshold,omitempty"`
-				Exceptions             []struct {
-					Values []struct {
-						ID   string   `json:"id,omitempty"`
-						Name string   `json:"name,omitempty"`
-						Ips  []string `json:"ips,omitempty"`
-						Urls []struct {
-							Value   string `json:"value,omitempty"`
-							Pattern string `json:"pattern,omitempty"`
-						} `json:"urls,omitempty"`
-						Geo struct {
-							Countries  []string `json:"countries,omitempty"`
-							Continents []string `json:"continents,omitempty"`
-						} `json:"geo,omitempty"`
-						ClientApps     []string `json:"client_apps,omitempty"`
-						ClientAppTypes []string `json:"client_app_types,omitempty"`
-						Parameters     []string `json:"parameters,omitempty"`
-						UserAgents     []string `json:"user_agents,omitempty"`
-					} `json:"values,omitempty"`
-					ID int `json:"id,omitempty"`
-				} `json:"exceptions,omitempty"`
+				Action                 string                    `json:"action,omitempty"`
+				ActionText             string                    `json:"action_text,omitempty"`
+				ID                     string                    `json:"id"`
+				Name                   string                    `json:"name"`
+				BlockBadBots           bool                      `json:"block_bad_bots,omitempty"`
+				ChallengeSuspectedBots bool                      `json:"challenge_suspected_bots,omitempty"`
+				ActivationMode         string                    `json:"activation_mode,omitempty"`
+				ActivationModeText     string                    `json:"activation_mode_text,omitempty"`
+				DdosTrafficThreshold   int                       `json:"ddos_traffic_threshold,omitempty"`
+				Exceptions             []SiteStatusRuleException `json:"exceptions,omitempty"`
 			} `json:"rules"`
 		} `json:"waf"`
 		Acls struct {
@@ -109,26 +115,7 @@ type SiteStatusResponse struct {
 					Value   string `json:"value"`
 					Pattern string `json:"pattern"`
 				} `json:"urls,omitempty"`
-				Exceptions []struct {
-					Values []struct {
-						ID   string   `json:"id"`
-						Name string   `json:"name"`
-						Ips  []string `json:"ips,omitempty"`
-						Urls []struct {
-							Value   string `json:"value"`
-							Pattern string `json:"pattern"`
-						} `json:"urls,omitempty"`
-						Geo struct {
-							Countries  []string `json:"countries"`
-							Continents []string `json:"continents"`
-						} `json:"geo,omitempty"`
-						ClientApps     []string `json:"client_apps,omitempty"`
-						ClientAppTypes []string `json:"client_app_types,omitempty"`
-						Parameters     []string `json:"parameters,omitempty"`
-						UserAgents     []string `json:"user_agents,omitempty"`
-					} `json:"values"`
-					ID int `json:"id"`
-				} `json:"exceptions"`
+				Exceptions []SiteStatusRuleException `json:"exceptions"`
 			} `json:"rules"`
 		} `json:"acls"`
 	} `json:"security"`
@@ -0,0 +1,107 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Endpoints (unexported consts)
+const endpointACLConfigure = "sites/configure/acl"
+
+// ACL Rule Enumerations, the blacklist rule IDs are shared with the security rule exceptions
+const whitelistedIPsACLRuleID = "api.acl.whitelisted_ips"
+
+// ACL param mapping by ruleID
+var siteACLParamMapping = map[string][]string{
+	blacklistedCountriesExceptionRuleID: {"countries", "continents"},
+	blacklistedURLsExceptionRuleID:      {"urls"},
+	blacklistedIPsExceptionRuleID:       {"ips"},
+	whitelistedIPsACLRuleID:             {"ips"},
+}
+
+// SiteACL contains the values of a site ACL rule
+type SiteACL struct {
+	Ips        []string
+	Countries  []string
+	Continents []string
+	Urls       []SecurityRuleExceptionURL
+}
+
+// ConfigureSiteACL sets the values of a site ACL rule, an empty list clears the rule
+func (c *Client) ConfigureSiteACL(siteID int, ruleID string, acl *SiteACL) (*SiteStatusResponse, error) {
+	// Base URL values
+	values := url.Values{
+		"site_id": {strconv.Itoa(siteID)},
+		"rule_id": {ruleID},
+	}
+
+	log.Printf("[INFO] Configuring Incapsula ACL rule id (%s) for site id (%d)\n", ruleID, siteID)
+
+	// Check to see if ruleID is correct, then add all the rule specific parameters (even empty, to clear them)
+	ruleParams, ok := siteACLParamMapping[ruleID]
+	if !ok {
+		return nil, fmt.Errorf("Error - invalid ACL rule_id (%s)", ruleID)
+	}
+	for _, param := range ruleParams {
+		switch param {
+		case "ips":
+			values.Add("ips", strings.Join(acl.Ips, ","))
+		case "countries":
+			values.Add("countries", strings.Join(acl.Countries, ","))
+		case "continents":
+			values.Add("continents", strings.Join(acl.Continents, ","))
+		case "urls":
+			urls := make([]string, 0, len(acl.Urls))
+			urlPatterns := make([]string, 0, len(acl.Urls))
+			for _, aclURL := range acl.Urls {
+				urls = append(urls, aclURL.URL)
+				urlPatterns = append(urlPatterns, aclURL.Pattern)
+			}
+			values.Add("urls", strings.Join(urls, ","))
+			values.Add("url_patterns", strings.Join(urlPatterns, ","))
+		}
+	}
+
+	// Post form to Incapsula
+	reqURL := fmt.Sprintf("%s/%s", c.config.BaseURL, endpointACLConfigure)
+	resp, err := c.PostFormWithHeaders(reqURL, values, UpdateSiteAcl)
+	if err != nil {
+		return nil, fmt.Errorf("Error configuring ACL rule_id (%s) for site_id (%d)", ruleID, siteID)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula configure ACL rule JSON response: %s\n", string(responseBody))
+
+	// Parse the JSON
+	var siteStatusResponse SiteStatusResponse
+	err = json.Unmarshal([]byte(responseBody), &siteStatusResponse)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing configure ACL rule JSON response for rule_id (%s) and site_id (%d)", ruleID, siteID)
+	}
+
+	// Res can sometimes oscillate between a string and number
+	// We need to add safeguards for this inside the provider
+	var resString string
+
+	if resNumber, ok := siteStatusResponse.Res.(float64); ok {
+		resString = fmt.Sprintf("%d", int(resNumber))
+	} else {
+		resString, _ = siteStatusResponse.Res.(string)
+	}
+
+	// Look at the response status code from Incapsula
+	if resString != "0" {
+		return nil, fmt.Errorf("Error from Incapsula service when configuring ACL rule for rule_id (%s) and site_id (%d): %s", ruleID, siteID, string(responseBody))
+	}
+
+	return &siteStatusResponse, nil
+}
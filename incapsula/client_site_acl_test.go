@@ -0,0 +1,157 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+////////////////////////////////////////////////////////////////
+// ConfigureSiteACL Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientConfigureSiteACLBadConnection(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[DEBUG] Running test client_site_acl.TestClientConfigureSiteACLBadConnection")
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+	siteID := 1234
+	ruleID := blacklistedIPsExceptionRuleID
+	siteStatusResponse, err := client.ConfigureSiteACL(siteID, ruleID, &SiteACL{Ips: []string{"1.2.3.4"}})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), fmt.Sprintf("Error configuring ACL rule_id (%s) for site_id (%d)", ruleID, siteID)) {
+		t.Errorf("Should have received a client error, got: %s", err)
+	}
+	if siteStatusResponse != nil {
+		t.Errorf("Should have received a nil siteStatusResponse instance")
+	}
+}
+
+func TestClientConfigureSiteACLInvalidRuleID(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[DEBUG] Running test client_site_acl.TestClientConfigureSiteACLInvalidRuleID")
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+	ruleID := "api.threats.backdoor"
+	siteStatusResponse, err := client.ConfigureSiteACL(1234, ruleID, &SiteACL{})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if err.Error() != fmt.Sprintf("Error - invalid ACL rule_id (%s)", ruleID) {
+		t.Errorf("Should have received an invalid rule_id error, got: %s", err)
+	}
+	if siteStatusResponse != nil {
+		t.Errorf("Should have received a nil siteStatusResponse instance")
+	}
+}
+
+func TestClientConfigureSiteACLBadJSON(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[DEBUG] Running test client_site_acl.TestClientConfigureSiteACLBadJSON")
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointACLConfigure) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointACLConfigure, req.URL.String())
+		}
+		rw.Write([]byte(`{`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	siteID := 1234
+	ruleID := blacklistedCountriesExceptionRuleID
+	siteStatusResponse, err := client.ConfigureSiteACL(siteID, ruleID, &SiteACL{Countries: []string{"FR"}})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), fmt.Sprintf("Error parsing configure ACL rule JSON response for rule_id (%s) and site_id (%d)", ruleID, siteID)) {
+		t.Errorf("Should have received a JSON parse error, got: %s", err)
+	}
+	if siteStatusResponse != nil {
+		t.Errorf("Should have received a nil siteStatusResponse instance")
+	}
+}
+
+func TestClientConfigureSiteACLInvalidSite(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[DEBUG] Running test client_site_acl.TestClientConfigureSiteACLInvalidSite")
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"res":9413,"res_message":"Unknown/unauthorized site_id","debug_info":{"id-info":"13007"}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	siteID := 1234
+	ruleID := whitelistedIPsACLRuleID
+	siteStatusResponse, err := client.ConfigureSiteACL(siteID, ruleID, &SiteACL{Ips: []string{"1.2.3.4"}})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), fmt.Sprintf("Error from Incapsula service when configuring ACL rule for rule_id (%s) and site_id (%d)", ruleID, siteID)) {
+		t.Errorf("Should have received a bad site error, got: %s", err)
+	}
+	if siteStatusResponse != nil {
+		t.Errorf("Should have received a nil siteStatusResponse instance")
+	}
+}
+
+func TestClientConfigureSiteACLValidRule(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[DEBUG] Running test client_site_acl.TestClientConfigureSiteACLValidRule")
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		req.ParseForm()
+		if req.PostForm.Get("urls") != "/admin,/login" || req.PostForm.Get("url_patterns") != "PREFIX,EQUALS" {
+			t.Errorf("Should have sent the urls with their patterns. Got: %s / %s", req.PostForm.Get("urls"), req.PostForm.Get("url_patterns"))
+		}
+		if _, ok := req.PostForm["ips"]; ok {
+			t.Errorf("Should not have sent ips for rule_id %s", blacklistedURLsExceptionRuleID)
+		}
+		rw.Write([]byte(`{"site_id":1234,"res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	acl := SiteACL{
+		Urls: []SecurityRuleExceptionURL{
+			{URL: "/admin", Pattern: "PREFIX"},
+			{URL: "/login", Pattern: "EQUALS"},
+		},
+	}
+	siteStatusResponse, err := client.ConfigureSiteACL(1234, blacklistedURLsExceptionRuleID, &acl)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if siteStatusResponse == nil {
+		t.Errorf("Should not have received a nil siteStatusResponse instance")
+	}
+}
+
+func TestClientConfigureSiteACLClear(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[DEBUG] Running test client_site_acl.TestClientConfigureSiteACLClear")
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		req.ParseForm()
+		for _, param := range []string{"countries", "continents"} {
+			if values, ok := req.PostForm[param]; !ok || len(values) != 1 || values[0] != "" {
+				t.Errorf("Should have sent an empty %s to clear the rule. Got: %v", param, values)
+			}
+		}
+		rw.Write([]byte(`{"site_id":1234,"res":"0"}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	_, err := client.ConfigureSiteACL(1234, blacklistedCountriesExceptionRuleID, &SiteACL{})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
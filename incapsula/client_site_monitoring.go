@@ -0,0 +1,116 @@
+package incapsula
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+)
+
+// SiteMonitoringStruct contains how Imperva monitors the origin servers of a site, and decides that a server is down
+type SiteMonitoringStruct struct {
+	FailedRequestsPercentage       int      `json:"failedRequestsPercentage"`
+	FailedRequestsMinNumber        int      `json:"failedRequestsMinNumber"`
+	FailedRequestsDuration         int      `json:"failedRequestsDuration"`
+	FailedRequestsDurationUnits    string   `json:"failedRequestsDurationUnits"`
+	HttpRequestTimeout             int      `json:"httpRequestTimeout"`
+	HttpRequestTimeoutUnits        string   `json:"httpRequestTimeoutUnits"`
+	HttpResponseError              string   `json:"httpResponseError"`
+	UseUpChecks                    bool     `json:"useUpChecks"`
+	UpChecksUrl                    string   `json:"upChecksUrl"`
+	UpChecksExpectedReceivedString string   `json:"upChecksExpectedReceivedString"`
+	UpCheckRetries                 int      `json:"upCheckRetries"`
+	UpChecksInterval               int      `json:"upChecksInterval"`
+	UpChecksIntervalUnits          string   `json:"upChecksIntervalUnits"`
+	AlarmOnStandsByFailover        bool     `json:"alarmOnStandsByFailover"`
+	AlarmOnDcFailover              bool     `json:"alarmOnDcFailover"`
+	AlarmOnServerFailover          bool     `json:"alarmOnServerFailover"`
+	AlarmRecipients                []string `json:"alarmRecipients"`
+}
+
+// Same DTO for: GET response, PUT request, and PUT response
+type SiteMonitoringDTO struct {
+	Errors []ApiError             `json:"errors"`
+	Data   []SiteMonitoringStruct `json:"data"`
+}
+
+// The monitoring settings of a new site, restored when the resource is deleted
+var siteMonitoringDefaults = SiteMonitoringStruct{
+	FailedRequestsPercentage:    40,
+	FailedRequestsMinNumber:     15,
+	FailedRequestsDuration:      40,
+	FailedRequestsDurationUnits: "SECONDS",
+	HttpRequestTimeout:          35,
+	HttpRequestTimeoutUnits:     "SECONDS",
+	HttpResponseError:           "501-599",
+	UseUpChecks:                 true,
+	UpChecksUrl:                 "/",
+	UpCheckRetries:              3,
+	UpChecksInterval:            20,
+	UpChecksIntervalUnits:       "SECONDS",
+	AlarmOnStandsByFailover:     true,
+	AlarmOnDcFailover:           true,
+	AlarmOnServerFailover:       false,
+	AlarmRecipients:             []string{},
+}
+
+// PutSiteMonitoring updates the origin servers monitoring settings of a site
+func (c *Client) PutSiteMonitoring(siteID string, requestDTO SiteMonitoringDTO) (*SiteMonitoringDTO, error) {
+	log.Printf("[INFO] Updating Incapsula site monitoring for siteID: %s\n", siteID)
+
+	baseURLv3 := c.config.BaseURL[:len(c.config.BaseURL)-3] + "/v3"
+	monitoringJSON, err := json.Marshal(requestDTO)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to JSON marshal site monitoring for siteID %s: %s", siteID, err)
+	}
+	reqURL := fmt.Sprintf("%s/sites/%s/monitoring", baseURLv3, siteID)
+	resp, err := c.DoJsonRequestWithHeaders(http.MethodPut, reqURL, monitoringJSON, UpdateSiteMonitoring)
+	if err != nil {
+		return nil, fmt.Errorf("Error executing update site monitoring request for siteID %s: %s", siteID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula Update site monitoring JSON response: %s\n", string(responseBody))
+
+	// Parse the JSON
+	var responseDTO SiteMonitoringDTO
+	err = json.Unmarshal([]byte(responseBody), &responseDTO)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing update site monitoring JSON response for siteID %s: %s\nresponse: %s", siteID, err, string(responseBody))
+	}
+
+	return &responseDTO, nil
+}
+
+// GetSiteMonitoring gets the origin servers monitoring settings of a site
+func (c *Client) GetSiteMonitoring(siteID string) (*SiteMonitoringDTO, error) {
+	log.Printf("[INFO] Getting site monitoring (site_id: %s)\n", siteID)
+
+	baseURLv3 := c.config.BaseURL[:len(c.config.BaseURL)-3] + "/v3"
+	reqURL := fmt.Sprintf("%s/sites/%s/monitoring", baseURLv3, siteID)
+	resp, err := c.DoJsonRequestWithHeaders(http.MethodGet, reqURL, nil, ReadSiteMonitoring)
+	if err != nil {
+		return nil, fmt.Errorf("Error executing get site monitoring request for siteID %s: %s", siteID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula site monitoring JSON response: %s\n", string(responseBody))
+
+	// Parse the JSON
+	var responseDTO SiteMonitoringDTO
+	err = json.Unmarshal([]byte(responseBody), &responseDTO)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing site monitoring JSON response for siteID %s: %s\nresponse: %s", siteID, err, string(responseBody))
+	}
+
+	return &responseDTO, nil
+}
@@ -0,0 +1,146 @@
+package incapsula
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+////////////////////////////////////////////////////////////////
+// PutSiteMonitoring Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientPutSiteMonitoringBadConnection(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+	siteID := "42"
+	responseDTO, err := client.PutSiteMonitoring(siteID, SiteMonitoringDTO{})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), fmt.Sprintf("Error executing update site monitoring request for siteID %s", siteID)) {
+		t.Errorf("Should have received an client error, got: %s", err)
+	}
+	if responseDTO != nil {
+		t.Errorf("Should have received a nil responseDTO instance")
+	}
+}
+
+func TestClientPutSiteMonitoringBadJSON(t *testing.T) {
+	siteID := "42"
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/api/prov/v3/sites/%s/monitoring", siteID) {
+			t.Errorf("Should have have hit /api/prov/v3/sites/%s/monitoring endpoint. Got: %s", siteID, req.URL.String())
+		}
+		rw.Write([]byte(`{`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL + "/api/prov/v1"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	responseDTO, err := client.PutSiteMonitoring(siteID, SiteMonitoringDTO{})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), fmt.Sprintf("Error parsing update site monitoring JSON response for siteID %s", siteID)) {
+		t.Errorf("Should have received a JSON parse error, got: %s", err)
+	}
+	if responseDTO != nil {
+		t.Errorf("Should have received a nil responseDTO instance")
+	}
+}
+
+func TestClientPutSiteMonitoringValid(t *testing.T) {
+	siteID := "42"
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.Method != http.MethodPut {
+			t.Errorf("Should have sent a PUT request. Got: %s", req.Method)
+		}
+		body, _ := ioutil.ReadAll(req.Body)
+		if !strings.Contains(string(body), `"upChecksUrl":"/health"`) || !strings.Contains(string(body), `"alarmRecipients":["noc@example.com"]`) {
+			t.Errorf("Unexpected request body: %s", string(body))
+		}
+		rw.Write(body)
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL + "/api/prov/v1"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	monitoring := siteMonitoringDefaults
+	monitoring.UpChecksUrl = "/health"
+	monitoring.AlarmRecipients = []string{"noc@example.com"}
+	responseDTO, err := client.PutSiteMonitoring(siteID, SiteMonitoringDTO{Data: []SiteMonitoringStruct{monitoring}})
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if responseDTO == nil || len(responseDTO.Data) != 1 || responseDTO.Data[0].UpChecksUrl != "/health" {
+		t.Errorf("Should have received the updated site monitoring, got: %+v", responseDTO)
+	}
+}
+
+////////////////////////////////////////////////////////////////
+// GetSiteMonitoring Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientGetSiteMonitoringBadConnection(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+	siteID := "42"
+	responseDTO, err := client.GetSiteMonitoring(siteID)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), fmt.Sprintf("Error executing get site monitoring request for siteID %s", siteID)) {
+		t.Errorf("Should have received an client error, got: %s", err)
+	}
+	if responseDTO != nil {
+		t.Errorf("Should have received a nil responseDTO instance")
+	}
+}
+
+func TestClientGetSiteMonitoringNotFound(t *testing.T) {
+	siteID := "42"
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/api/prov/v3/sites/%s/monitoring", siteID) {
+			t.Errorf("Should have have hit /api/prov/v3/sites/%s/monitoring endpoint. Got: %s", siteID, req.URL.String())
+		}
+		rw.Write([]byte(`{"errors":[{"status": "404"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL + "/api/prov/v1"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	responseDTO, err := client.GetSiteMonitoring(siteID)
+	if err != nil {
+		t.Errorf("Should not receive an error. Got: %s", err.Error())
+	}
+	if responseDTO == nil || len(responseDTO.Errors) != 1 || responseDTO.Errors[0].Status != "404" {
+		t.Errorf("Should have received a response DTO instance with a 404 error item, got: %+v", responseDTO)
+	}
+}
+
+func TestClientGetSiteMonitoringValid(t *testing.T) {
+	siteID := "42"
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"data":[{"failedRequestsPercentage":30,"failedRequestsMinNumber":10,"failedRequestsDuration":1,"failedRequestsDurationUnits":"MINUTES","httpResponseError":"500-599","useUpChecks":true,"upChecksUrl":"/health","upChecksInterval":30,"upChecksIntervalUnits":"SECONDS"}]}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL + "/api/prov/v1"}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	responseDTO, err := client.GetSiteMonitoring(siteID)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if responseDTO == nil || len(responseDTO.Data) != 1 {
+		t.Fatalf("Should have received the site monitoring, got: %+v", responseDTO)
+	}
+	monitoring := responseDTO.Data[0]
+	if monitoring.FailedRequestsPercentage != 30 || monitoring.FailedRequestsDurationUnits != "MINUTES" || monitoring.UpChecksUrl != "/health" {
+		t.Errorf("Unexpected site monitoring: %+v", monitoring)
+	}
+}
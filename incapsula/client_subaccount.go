@@ -13,6 +13,8 @@ import (
 const endpointSubAccountAdd = "subaccounts/add"
 const endpointSubAccountList = "accounts/listSubAccounts"
 const endpointSubAccountDelete = "subaccounts/delete"
+const endpointSubAccountGet = "subaccounts/get"
+const endpointSubAccountUpdate = "subaccounts/configure"
 const PAGE_SIZE = 50
 
 type SubAccount struct {
@@ -26,6 +28,12 @@ type SubAccountAddResponse struct {
 	Res        int        `json:"res"`
 }
 
+// SubAccountGetResponse contains the Incapsula SubAccount read by id
+type SubAccountGetResponse struct {
+	SubAccount SubAccount `json:"sub_account"`
+	Res        int        `json:"res"`
+}
+
 // SubAccountListResponse contains list of Incapsula SubAccount
 type SubAccountListResponse struct {
 	SubAccounts []SubAccount `json:"resultList"`
@@ -102,31 +110,107 @@ func (c *Client) AddSubAccount(subAccountPayload *SubAccountPayload) (*SubAccoun
 	return &subAccountAddResponse, nil
 }
 
-// GetSubAccount gets the Incapsula list of SubAccounts
-func (c *Client) GetSubAccount(parentAccountID int, subAccountID int) (*SubAccount, error) {
+// GetSubAccountByID gets an Incapsula SubAccount by id, nil when the SubAccount doesn't exist
+func (c *Client) GetSubAccountByID(subAccountID int) (*SubAccount, error) {
+	log.Printf("[INFO] Reading Incapsula subaccount id: %d\n", subAccountID)
+
+	// Post form to Incapsula
+	resp, err := c.PostFormWithHeaders(fmt.Sprintf("%s/%s", c.config.BaseURL, endpointSubAccountGet), url.Values{
+		"sub_account_id": {strconv.Itoa(subAccountID)},
+	}, ReadSubAccount)
+	if err != nil {
+		return nil, fmt.Errorf("Error getting subaccount id: %d: %s", subAccountID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula get subaccount JSON response: %s\n", string(responseBody))
+
+	// Parse the JSON
+	var subAccountGetResponse SubAccountGetResponse
+	err = json.Unmarshal([]byte(responseBody), &subAccountGetResponse)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing get subaccount JSON response for subaccount id: %d: %s", subAccountID, err)
+	}
+
+	// Unknown or deleted account
+	if subAccountGetResponse.Res == 9403 {
+		log.Printf("[DEBUG] didn't find subaccount %d returning nil", subAccountID)
+		return nil, nil
+	}
+
+	// Look at the response status code from Incapsula
+	if subAccountGetResponse.Res != 0 {
+		return nil, fmt.Errorf("Error from Incapsula service when getting subaccount id: %d: %s", subAccountID, string(responseBody))
+	}
+	if subAccountGetResponse.SubAccount.SubAccountPayload == nil {
+		subAccountGetResponse.SubAccount.SubAccountPayload = &SubAccountPayload{}
+	}
+	subAccountGetResponse.SubAccount.SubAccountID = subAccountID
+
+	return &subAccountGetResponse.SubAccount, nil
+}
+
+// UpdateSubAccount updates the specific param/value of a SubAccount
+func (c *Client) UpdateSubAccount(subAccountID int, param, value string) error {
+	// We only care about the response code and possibly the message
+	type SubAccountUpdateResponse struct {
+		Res        int    `json:"res"`
+		ResMessage string `json:"res_message"`
+	}
+
+	log.Printf("[INFO] Updating Incapsula subaccount param (%s) with value (%s) for subaccount id: %d\n", param, value, subAccountID)
+
+	// Post form to Incapsula
+	resp, err := c.PostFormWithHeaders(fmt.Sprintf("%s/%s", c.config.BaseURL, endpointSubAccountUpdate), url.Values{
+		"sub_account_id": {strconv.Itoa(subAccountID)},
+		"param":          {param},
+		"value":          {value},
+	}, UpdateSubAccount)
+	if err != nil {
+		return fmt.Errorf("Error updating param (%s) with value (%s) on subaccount id: %d: %s", param, value, subAccountID, err)
+	}
+
+	// Read the body
+	defer resp.Body.Close()
+	responseBody, err := ioutil.ReadAll(resp.Body)
+
+	// Dump JSON
+	log.Printf("[DEBUG] Incapsula update subaccount JSON response: %s\n", string(responseBody))
+
+	// Parse the JSON
+	var subAccountUpdateResponse SubAccountUpdateResponse
+	err = json.Unmarshal([]byte(responseBody), &subAccountUpdateResponse)
+	if err != nil {
+		return fmt.Errorf("Error parsing update subaccount JSON response for subaccount id: %d: %s", subAccountID, err)
+	}
+
+	// Look at the response status code from Incapsula
+	if subAccountUpdateResponse.Res != 0 {
+		return fmt.Errorf("Error from Incapsula service when updating subaccount id: %d: %s", subAccountID, string(responseBody))
+	}
+
+	return nil
+}
 
-	log.Printf("[INFO] Reading Incapsula subaccounts for id: %d)", subAccountID)
+// ListSubAccounts gets all the SubAccounts of an account, fetching all the pages
+func (c *Client) ListSubAccounts(parentAccountID int) ([]SubAccount, error) {
+	log.Printf("[INFO] Listing Incapsula subaccounts of account id: %d\n", parentAccountID)
 
-	var count = 0
-	var shouldFetch = true
-	// Pagination (default page size 50)
-	for shouldFetch {
-		log.Printf("[DEBUG] looking for subaccount %d, fetching for page: %d", subAccountID, count)
-		var subAccounts, error = c.sendListSubAccountsRequest(parentAccountID, count)
-		if error != nil {
-			return nil, error
+	var allSubAccounts []SubAccount
+	for pageNum := 0; ; pageNum++ {
+		subAccounts, err := c.sendListSubAccountsRequest(parentAccountID, pageNum)
+		if err != nil {
+			return nil, err
 		}
-		for _, subAccount := range subAccounts {
-			if subAccount.SubAccountID == subAccountID {
-				log.Printf("[INFO] found subaccount : %v\n", subAccount)
-				return &subAccount, nil
-			}
+		allSubAccounts = append(allSubAccounts, subAccounts...)
+		if len(subAccounts) < PAGE_SIZE {
+			return allSubAccounts, nil
 		}
-		shouldFetch = len(subAccounts) == PAGE_SIZE
-		count += 1
 	}
-	log.Printf("[DEBUG] didn't find subaccount %d returning nil", subAccountID)
-	return nil, nil
 }
 
 func (c *Client) sendListSubAccountsRequest(accountId int, pageNum int) ([]SubAccount, error) {
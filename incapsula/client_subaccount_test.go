@@ -150,3 +150,174 @@ func TestClientDeleteSubAccountValidAccount(t *testing.T) {
 		t.Errorf("Should not have received an error")
 	}
 }
+
+//////////////////////////////////////////////////////////////
+/// 	ListSubAccounts Tests
+//////////////////////////////////////////////////////////////
+
+func TestClientListSubAccountsBadConnection(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+	subAccounts, err := client.ListSubAccounts(123)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), fmt.Sprintf("Error getting subaccounts for account 123")) {
+		t.Errorf("Should have received an client error, got: %s", err)
+	}
+	if subAccounts != nil {
+		t.Errorf("Should have received a nil subAccounts instance")
+	}
+}
+
+func TestClientListSubAccountsValidPages(t *testing.T) {
+	pages := []string{}
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointSubAccountList) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointSubAccountList, req.URL.String())
+		}
+		pageNum := req.FormValue("page_num")
+		pages = append(pages, pageNum)
+
+		// A full first page, then a partial second page
+		subAccounts := []string{}
+		firstID, count := 100, PAGE_SIZE
+		if pageNum == "1" {
+			firstID, count = 200, 2
+		}
+		for i := 0; i < count; i++ {
+			subAccounts = append(subAccounts, fmt.Sprintf(`{"sub_account_id":%d,"sub_account_name":"sub","ref_id":"ref"}`, firstID+i))
+		}
+		rw.Write([]byte(fmt.Sprintf(`{"resultList":[%s],"res":0}`, strings.Join(subAccounts, ","))))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	subAccounts, err := client.ListSubAccounts(123)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if len(subAccounts) != PAGE_SIZE+2 {
+		t.Errorf("Should have received %d subaccounts, got: %d", PAGE_SIZE+2, len(subAccounts))
+	}
+	if strings.Join(pages, ",") != "0,1" {
+		t.Errorf("Should have fetched pages 0,1, got: %s", strings.Join(pages, ","))
+	}
+	if subAccounts[PAGE_SIZE+1].SubAccountID != 201 || subAccounts[PAGE_SIZE+1].RefID != "ref" {
+		t.Errorf("Unexpected last subaccount: %+v", subAccounts[PAGE_SIZE+1])
+	}
+}
+
+//////////////////////////////////////////////////////////////
+/// 	GetSubAccountByID Tests
+//////////////////////////////////////////////////////////////
+
+func TestClientGetSubAccountByIDBadConnection(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+	subAccount, err := client.GetSubAccountByID(123)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error getting subaccount id: 123") {
+		t.Errorf("Should have received an client error, got: %s", err)
+	}
+	if subAccount != nil {
+		t.Errorf("Should have received a nil subAccount instance")
+	}
+}
+
+func TestClientGetSubAccountByIDUnknownAccount(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"res":9403,"res_message":"Unknown/unauthorized account_id"}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	subAccount, err := client.GetSubAccountByID(123)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if subAccount != nil {
+		t.Errorf("Should have received a nil subAccount instance")
+	}
+}
+
+func TestClientGetSubAccountByIDValidAccount(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointSubAccountGet) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointSubAccountGet, req.URL.String())
+		}
+		if req.FormValue("sub_account_id") != "123" {
+			t.Errorf("Should have sent sub_account_id 123, got: %s", req.FormValue("sub_account_id"))
+		}
+		rw.Write([]byte(`{"sub_account":{"sub_account_id":123,"sub_account_name":"sub","ref_id":"ref","log_level":"full","parent_id":1,"logs_account_id":2},"res":0}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	subAccount, err := client.GetSubAccountByID(123)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if subAccount == nil || subAccount.SubAccountID != 123 || subAccount.SubAccountName != "sub" || subAccount.RefID != "ref" ||
+		subAccount.LogLevel != "full" || subAccount.ParentID != 1 || subAccount.LogsAccountID != 2 {
+		t.Errorf("Unexpected subaccount: %+v", subAccount)
+	}
+}
+
+//////////////////////////////////////////////////////////////
+/// 	UpdateSubAccount Tests
+//////////////////////////////////////////////////////////////
+
+func TestClientUpdateSubAccountBadConnection(t *testing.T) {
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: "badness.incapsula.com"}
+	client := &Client{config: config, httpClient: &http.Client{Timeout: time.Millisecond * 1}}
+	err := client.UpdateSubAccount(123, "sub_account_name", "renamed")
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error updating param (sub_account_name) with value (renamed) on subaccount id: 123") {
+		t.Errorf("Should have received an client error, got: %s", err)
+	}
+}
+
+func TestClientUpdateSubAccountInvalidAccount(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"res":9403,"res_message":"Unknown/unauthorized account_id"}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.UpdateSubAccount(123, "sub_account_name", "renamed")
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error from Incapsula service when updating subaccount id: 123") {
+		t.Errorf("Should have received a bad subaccount error, got: %s", err)
+	}
+}
+
+func TestClientUpdateSubAccountValidAccount(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointSubAccountUpdate) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointSubAccountUpdate, req.URL.String())
+		}
+		if req.FormValue("sub_account_id") != "123" || req.FormValue("param") != "sub_account_name" || req.FormValue("value") != "renamed" {
+			t.Errorf("Unexpected form: %v", req.Form)
+		}
+		rw.Write([]byte(`{"res":0,"res_message":"OK"}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	err := client.UpdateSubAccount(123, "sub_account_name", "renamed")
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+}
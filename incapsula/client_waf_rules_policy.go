@@ -0,0 +1,75 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+)
+
+const wafRulesPolicyType = "WAF_RULES"
+
+// WAF Rules policy setting types
+const wafRulesSettingTypeSQLInjection = "SQL_INJECTION"
+const wafRulesSettingTypeCrossSiteScripting = "CROSS_SITE_SCRIPTING"
+const wafRulesSettingTypeIllegalResourceAccess = "ILLEGAL_RESOURCE_ACCESS"
+const wafRulesSettingTypeRemoteFileInclusion = "REMOTE_FILE_INCLUSION"
+
+// WAF Rules policy setting actions
+const wafRulesActionBlock = "BLOCK"
+const wafRulesActionBlockUser = "BLOCK_USER"
+const wafRulesActionBlockIP = "BLOCK_IP"
+const wafRulesActionAlert = "ALERT"
+const wafRulesActionIgnore = "IGNORE"
+
+// wafRulesPolicyLegacyRuleMapping maps the legacy v1 WAF rule IDs to their WAF Rules policy setting type
+var wafRulesPolicyLegacyRuleMapping = map[string]string{
+	sqlInjectionRuleID:          wafRulesSettingTypeSQLInjection,
+	crossSiteScriptingRuleID:    wafRulesSettingTypeCrossSiteScripting,
+	illegalResourceAccessRuleID: wafRulesSettingTypeIllegalResourceAccess,
+	remoteFileInclusionRuleID:   wafRulesSettingTypeRemoteFileInclusion,
+}
+
+// wafRulesPolicyLegacyActionMapping maps the legacy v1 security rule actions to their WAF Rules policy action
+var wafRulesPolicyLegacyActionMapping = map[string]string{
+	"api.threats.action.block_request": wafRulesActionBlock,
+	"api.threats.action.block_user":    wafRulesActionBlockUser,
+	"api.threats.action.block_ip":      wafRulesActionBlockIP,
+	"api.threats.action.alert":         wafRulesActionAlert,
+	"api.threats.action.disabled":      wafRulesActionIgnore,
+}
+
+// GetWAFRulesPolicySettingsFromSite reads the legacy (v1) WAF settings of a site and converts them
+// to the equivalent WAF Rules policy settings
+func (c *Client) GetWAFRulesPolicySettingsFromSite(siteID int) (*SiteStatusResponse, []PolicySetting, error) {
+	log.Printf("[INFO] Converting Incapsula WAF rules of site id (%d) to WAF Rules policy settings\n", siteID)
+
+	siteStatusResponse, err := c.SiteStatus("waf-rules-policy-migration", siteID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	policySettings := make([]PolicySetting, 0, len(wafRulesPolicyLegacyRuleMapping))
+	for _, rule := range siteStatusResponse.Security.Waf.Rules {
+		settingType, ok := wafRulesPolicyLegacyRuleMapping[rule.ID]
+		if !ok {
+			// DDoS, bot access control and backdoor protection are not part of the WAF Rules policy
+			log.Printf("[DEBUG] Skipping WAF rule id (%s) of site id (%d), it is not part of the WAF Rules policy\n", rule.ID, siteID)
+			continue
+		}
+
+		action, ok := wafRulesPolicyLegacyActionMapping[rule.Action]
+		if !ok {
+			return nil, nil, fmt.Errorf("Error converting WAF rule id (%s) of site id (%d): unsupported security rule action (%s)", rule.ID, siteID, rule.Action)
+		}
+
+		policySettings = append(policySettings, PolicySetting{
+			PolicySettingType: settingType,
+			SettingsAction:    action,
+		})
+	}
+
+	if len(policySettings) != len(wafRulesPolicyLegacyRuleMapping) {
+		return nil, nil, fmt.Errorf("Error converting WAF rules of site id (%d): expected %d WAF rules in site status, found %d", siteID, len(wafRulesPolicyLegacyRuleMapping), len(policySettings))
+	}
+
+	return siteStatusResponse, policySettings, nil
+}
@@ -0,0 +1,101 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+////////////////////////////////////////////////////////////////
+// GetWAFRulesPolicySettingsFromSite Tests
+////////////////////////////////////////////////////////////////
+
+func TestClientGetWAFRulesPolicySettingsFromSiteValidSite(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[DEBUG] Running test client_waf_rules_policy.TestClientGetWAFRulesPolicySettingsFromSiteValidSite")
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s", endpointSiteStatus) {
+			t.Errorf("Should have have hit /%s endpoint. Got: %s", endpointSiteStatus, req.URL.String())
+		}
+		rw.Write([]byte(`{"site_id":1234,"domain":"example.com","account_id":42,"res":0,"security":{"waf":{"rules":[
+			{"id":"api.threats.sql_injection","action":"api.threats.action.block_request"},
+			{"id":"api.threats.cross_site_scripting","action":"api.threats.action.block_ip"},
+			{"id":"api.threats.illegal_resource_access","action":"api.threats.action.alert"},
+			{"id":"api.threats.remote_file_inclusion","action":"api.threats.action.disabled"},
+			{"id":"api.threats.backdoor","action":"api.threats.action.quarantine_url"},
+			{"id":"api.threats.ddos","activation_mode":"api.threats.ddos.activation_mode.auto","ddos_traffic_threshold":1000}
+		]}}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	siteStatusResponse, policySettings, err := client.GetWAFRulesPolicySettingsFromSite(1234)
+	if err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+	if siteStatusResponse == nil || siteStatusResponse.AccountID != 42 {
+		t.Errorf("Should have received the site status of account 42")
+	}
+
+	expected := map[string]string{
+		wafRulesSettingTypeSQLInjection:          wafRulesActionBlock,
+		wafRulesSettingTypeCrossSiteScripting:    wafRulesActionBlockIP,
+		wafRulesSettingTypeIllegalResourceAccess: wafRulesActionAlert,
+		wafRulesSettingTypeRemoteFileInclusion:   wafRulesActionIgnore,
+	}
+	if len(policySettings) != len(expected) {
+		t.Fatalf("Should have received %d policy settings, got: %d", len(expected), len(policySettings))
+	}
+	for _, policySetting := range policySettings {
+		if expected[policySetting.PolicySettingType] != policySetting.SettingsAction {
+			t.Errorf("Unexpected action %s for policy setting type %s", policySetting.SettingsAction, policySetting.PolicySettingType)
+		}
+	}
+}
+
+func TestClientGetWAFRulesPolicySettingsFromSiteUnsupportedAction(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[DEBUG] Running test client_waf_rules_policy.TestClientGetWAFRulesPolicySettingsFromSiteUnsupportedAction")
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"site_id":1234,"res":0,"security":{"waf":{"rules":[
+			{"id":"api.threats.sql_injection","action":"api.threats.action.quarantine_url"}
+		]}}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	siteStatusResponse, policySettings, err := client.GetWAFRulesPolicySettingsFromSite(1234)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error converting WAF rule id (api.threats.sql_injection) of site id (1234)") {
+		t.Errorf("Should have received a conversion error, got: %s", err)
+	}
+	if siteStatusResponse != nil || policySettings != nil {
+		t.Errorf("Should have received nil results")
+	}
+}
+
+func TestClientGetWAFRulesPolicySettingsFromSiteMissingRules(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[DEBUG] Running test client_waf_rules_policy.TestClientGetWAFRulesPolicySettingsFromSiteMissingRules")
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		rw.Write([]byte(`{"site_id":1234,"res":0,"security":{"waf":{"rules":[]}}}`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	_, _, err := client.GetWAFRulesPolicySettingsFromSite(1234)
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+	if !strings.HasPrefix(err.Error(), "Error converting WAF rules of site id (1234)") {
+		t.Errorf("Should have received a conversion error, got: %s", err)
+	}
+}
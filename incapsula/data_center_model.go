@@ -0,0 +1,215 @@
+package incapsula
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+// Resource models which can manage the data centers of a site
+const (
+	dataCenterModelLegacy        = "incapsula_data_center/incapsula_data_center_server"
+	dataCenterModelConfiguration = "incapsula_data_centers_configuration"
+)
+
+// siteDataCenterModels records the models managing the data centers of each site read by the provider, so that a site
+// managed by both the legacy resources and incapsula_data_centers_configuration can be reported.
+// Only the resources read by the same provider instance are known, i.e. in the same Terraform configuration,
+// getUnmanagedDataCenterDiagnostics detects the data centers added by another configuration from the API.
+type siteDataCenterModels struct {
+	mutex  sync.Mutex
+	models map[string]map[string]bool
+}
+
+// record adds the model of the site, and returns the sorted other models already recorded for the site
+func (s *siteDataCenterModels) record(siteID, model string) []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.models == nil {
+		s.models = map[string]map[string]bool{}
+	}
+	if s.models[siteID] == nil {
+		s.models[siteID] = map[string]bool{}
+	}
+	s.models[siteID][model] = true
+
+	others := []string{}
+	for other := range s.models[siteID] {
+		if other != model {
+			others = append(others, other)
+		}
+	}
+	sort.Strings(others)
+
+	return others
+}
+
+// getDataCenterModelConflictDiagnostics records the model of the site and warns when the site is also managed by
+// another model, as each one overwrites the data centers of the other
+func getDataCenterModelConflictDiagnostics(client *Client, siteID, model string) diag.Diagnostics {
+	others := client.dataCenterModels.record(siteID, model)
+	if len(others) == 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Data centers of site %s are managed by more than one resource type", siteID),
+		Detail: fmt.Sprintf("The data centers of site %s are managed by %s and by %s, which overwrite each other's changes. "+
+			"Manage them with incapsula_data_centers_configuration only: create it with adopt_existing = true, then remove the legacy resources from the state.",
+			siteID, model, strings.Join(others, ", ")),
+	}}
+}
+
+// getDataCenterNames returns the names of the data_center blocks
+func getDataCenterNames(dataCenters []interface{}) map[string]bool {
+	names := map[string]bool{}
+	for _, dataCenter := range dataCenters {
+		if dc, ok := dataCenter.(map[string]interface{}); ok {
+			names[dc["name"].(string)] = true
+		}
+	}
+
+	return names
+}
+
+// getUnmanagedDataCenterDiagnostics warns about the data centers read from the site which weren't in the previous state
+// of incapsula_data_centers_configuration, i.e. added by the legacy resources or outside of Terraform.
+// Nothing is reported without a previous state, e.g. on import.
+func getUnmanagedDataCenterDiagnostics(siteID string, previous, current []interface{}) diag.Diagnostics {
+	previousNames := getDataCenterNames(previous)
+	if len(previousNames) == 0 {
+		return nil
+	}
+
+	unmanaged := []string{}
+	for name := range getDataCenterNames(current) {
+		if !previousNames[name] {
+			unmanaged = append(unmanaged, name)
+		}
+	}
+	if len(unmanaged) == 0 {
+		return nil
+	}
+	sort.Strings(unmanaged)
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Data centers of site %s were added outside of %s", siteID, dataCenterModelConfiguration),
+		Detail: fmt.Sprintf("The data centers %s of site %s were added outside of %s, e.g. by %s, and are deleted by the next apply "+
+			"unless they are added to the configuration.",
+			strings.Join(unmanaged, ", "), siteID, dataCenterModelConfiguration, dataCenterModelLegacy),
+	}}
+}
+
+// getExistingDataCenterIDs maps the names of the existing data centers of a site to their id
+func getExistingDataCenterIDs(dataCenters []DataCenterStruct) map[string]int {
+	ids := map[string]int{}
+	for _, dc := range dataCenters {
+		if dc.ID != nil {
+			ids[dc.Name] = *dc.ID
+		}
+	}
+
+	return ids
+}
+
+// flattenDataCentersConfiguration returns the values of a configuration by path, e.g. data_center["dc1"].ip_mode.
+// Data centers and origin servers have an empty value at their own path, e.g. data_center["dc1"].origin_server["1.2.3.4"].
+// Weights and web servers per server are only set when they are used.
+func flattenDataCentersConfiguration(configuration DataCentersStruct) map[string]string {
+	values := map[string]string{
+		"site_lb_algorithm":               configuration.SiteLbAlgorithm,
+		"fail_over_required_monitors":     configuration.FailOverRequiredMonitors,
+		"site_topology":                   configuration.DataCenterMode,
+		"min_available_servers_for_dc_up": fmt.Sprint(configuration.MinAvailableServersForDataCenterUp),
+		"kickstart_url":                   configuration.KickStartURL,
+		"kickstart_user":                  configuration.KickStartUser,
+		"kickstart_password":              configuration.KickStartPass,
+		"is_persistent":                   fmt.Sprint(configuration.IsPersistent),
+	}
+
+	for _, dc := range configuration.DataCenters {
+		path := fmt.Sprintf("data_center[%q]", dc.Name)
+		values[path] = ""
+		values[path+".ip_mode"] = dc.IpMode
+		if dc.IpMode == "SINGLE_IP" {
+			webServersPerServer := 1
+			if dc.WebServersPerServer != nil {
+				webServersPerServer = *dc.WebServersPerServer
+			}
+			values[path+".web_servers_per_server"] = fmt.Sprint(webServersPerServer)
+		}
+		values[path+".dc_lb_algorithm"] = dc.DcLbAlgorithm
+		if configuration.SiteLbAlgorithm == "WEIGHTED_LB" && dc.Weight != nil {
+			values[path+".weight"] = fmt.Sprint(*dc.Weight)
+		}
+		values[path+".is_enabled"] = fmt.Sprint(dc.IsEnabled)
+		values[path+".is_active"] = fmt.Sprint(dc.IsActive)
+		values[path+".is_content"] = fmt.Sprint(dc.IsContent)
+		values[path+".is_rest_of_the_world"] = fmt.Sprint(dc.IsRestOfTheWorld)
+		geoLocations := append([]string{}, dc.GeoLocations...)
+		sort.Strings(geoLocations)
+		values[path+".geo_locations"] = strings.Join(geoLocations, ",")
+		values[path+".origin_pop"] = dc.OriginPoP
+
+		for _, server := range dc.OriginServers {
+			serverPath := fmt.Sprintf("%s.origin_server[%q]", path, server.Address)
+			values[serverPath] = ""
+			if dc.DcLbAlgorithm == "WEIGHTED" && server.Weight != nil {
+				values[serverPath+".weight"] = fmt.Sprint(*server.Weight)
+			}
+			values[serverPath+".is_enabled"] = fmt.Sprint(server.IsEnabled)
+			values[serverPath+".is_active"] = fmt.Sprint(server.ServerMode != "STANDBY")
+		}
+	}
+
+	return values
+}
+
+// diffDataCentersConfiguration returns the sorted differences between the existing configuration of a site and the
+// requested one, an empty list when the requested configuration can be adopted without changes
+func diffDataCentersConfiguration(existing, requested DataCentersStruct) []string {
+	existingValues := flattenDataCentersConfiguration(existing)
+	requestedValues := flattenDataCentersConfiguration(requested)
+
+	paths := []string{}
+	for path := range existingValues {
+		paths = append(paths, path)
+	}
+	for path := range requestedValues {
+		if _, ok := existingValues[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	differences := []string{}
+	for _, path := range paths {
+		existingValue, inExisting := existingValues[path]
+		requestedValue, inRequested := requestedValues[path]
+		isObject := strings.HasSuffix(path, "]")
+
+		switch {
+		case inExisting && inRequested:
+			if existingValue == requestedValue {
+				continue
+			}
+			if path == "kickstart_password" {
+				differences = append(differences, "kickstart_password differs")
+				continue
+			}
+			differences = append(differences, fmt.Sprintf("%s is %q on the site, %q in the configuration", path, existingValue, requestedValue))
+		case isObject && inExisting:
+			differences = append(differences, fmt.Sprintf("%s is on the site, not in the configuration", path))
+		case isObject && inRequested:
+			differences = append(differences, fmt.Sprintf("%s is in the configuration, not on the site", path))
+		}
+	}
+
+	return differences
+}
@@ -0,0 +1,112 @@
+package incapsula
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestSiteDataCenterModelsRecord(t *testing.T) {
+	client := &Client{}
+
+	if others := client.dataCenterModels.record("1", dataCenterModelLegacy); len(others) != 0 {
+		t.Errorf("Expected no other model, got: %v", others)
+	}
+	if others := client.dataCenterModels.record("1", dataCenterModelLegacy); len(others) != 0 {
+		t.Errorf("Expected no other model when the same model is recorded twice, got: %v", others)
+	}
+	if others := client.dataCenterModels.record("2", dataCenterModelConfiguration); len(others) != 0 {
+		t.Errorf("Expected no other model for another site, got: %v", others)
+	}
+
+	diags := getDataCenterModelConflictDiagnostics(client, "1", dataCenterModelConfiguration)
+	if len(diags) != 1 || !strings.Contains(diags[0].Detail, dataCenterModelLegacy) {
+		t.Errorf("Expected a warning about %s, got: %v", dataCenterModelLegacy, diags)
+	}
+}
+
+func TestGetUnmanagedDataCenterDiagnostics(t *testing.T) {
+	previous := []interface{}{map[string]interface{}{"name": "main"}}
+	current := []interface{}{map[string]interface{}{"name": "main"}, map[string]interface{}{"name": "legacy"}}
+
+	if diags := getUnmanagedDataCenterDiagnostics("1", previous, previous); len(diags) != 0 {
+		t.Errorf("Expected no warning when the data centers are unchanged, got: %v", diags)
+	}
+	if diags := getUnmanagedDataCenterDiagnostics("1", nil, current); len(diags) != 0 {
+		t.Errorf("Expected no warning without a previous state, got: %v", diags)
+	}
+	diags := getUnmanagedDataCenterDiagnostics("1", previous, current)
+	if len(diags) != 1 || !strings.Contains(diags[0].Detail, "legacy of site 1") {
+		t.Errorf("Expected a warning about the legacy data center, got: %v", diags)
+	}
+}
+
+func TestDiffDataCentersConfiguration(t *testing.T) {
+	id, weight, otherWeight := 10, 100, 50
+	existing := DataCentersStruct{
+		SiteLbAlgorithm: "BEST_CONNECTION_TIME",
+		DataCenterMode:  "MULTIPLE_DC",
+		IsPersistent:    true,
+		DataCenters: []DataCenterStruct{
+			{
+				Name:          "main",
+				ID:            &id,
+				IpMode:        "MULTIPLE_IP",
+				DcLbAlgorithm: "WEIGHTED",
+				Weight:        &otherWeight,
+				IsEnabled:     true,
+				IsActive:      true,
+				GeoLocations:  []string{"EUROPE", "ASIA"},
+				OriginServers: []OriginServerStruct{{Address: "1.2.3.4", IsEnabled: true, ServerMode: "ACTIVE", Weight: &weight}},
+			},
+			{Name: "legacy", IpMode: "MULTIPLE_IP", IsEnabled: true, IsActive: true},
+		},
+	}
+	requested := DataCentersStruct{
+		SiteLbAlgorithm: "BEST_CONNECTION_TIME",
+		DataCenterMode:  "MULTIPLE_DC",
+		IsPersistent:    true,
+		DataCenters: []DataCenterStruct{
+			{
+				Name:          "main",
+				IpMode:        "MULTIPLE_IP",
+				DcLbAlgorithm: "WEIGHTED",
+				IsEnabled:     true,
+				IsActive:      true,
+				GeoLocations:  []string{"ASIA", "EUROPE"},
+				OriginServers: []OriginServerStruct{{Address: "1.2.3.4", IsEnabled: true, ServerMode: "ACTIVE", Weight: &weight}},
+			},
+			{Name: "legacy", IpMode: "MULTIPLE_IP", IsEnabled: true, IsActive: true},
+		},
+	}
+
+	// Ids, the unused data center weights and the order of the geo locations are ignored
+	if differences := diffDataCentersConfiguration(existing, requested); len(differences) != 0 {
+		t.Errorf("Expected no differences, got: %v", differences)
+	}
+
+	requested.IsPersistent = false
+	requested.KickStartPass = "secret"
+	requested.DataCenters[0].OriginServers = []OriginServerStruct{{Address: "1.2.3.4", IsEnabled: true, ServerMode: "STANDBY", Weight: &otherWeight}}
+	requested.DataCenters[1] = DataCenterStruct{Name: "new", IpMode: "MULTIPLE_IP", IsEnabled: true, IsActive: true}
+
+	expected := []string{
+		`data_center["legacy"] is on the site, not in the configuration`,
+		`data_center["main"].origin_server["1.2.3.4"].is_active is "true" on the site, "false" in the configuration`,
+		`data_center["main"].origin_server["1.2.3.4"].weight is "100" on the site, "50" in the configuration`,
+		`data_center["new"] is in the configuration, not on the site`,
+		`is_persistent is "true" on the site, "false" in the configuration`,
+		`kickstart_password differs`,
+	}
+	if differences := diffDataCentersConfiguration(existing, requested); fmt.Sprint(differences) != fmt.Sprint(expected) {
+		t.Errorf("Expected %v, got: %v", expected, differences)
+	}
+}
+
+func TestGetExistingDataCenterIDs(t *testing.T) {
+	id := 10
+	ids := getExistingDataCenterIDs([]DataCenterStruct{{Name: "main", ID: &id}, {Name: "new"}})
+	if fmt.Sprint(ids) != "map[main:10]" {
+		t.Errorf("Expected map[main:10], got: %v", ids)
+	}
+}
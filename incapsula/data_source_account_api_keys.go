@@ -0,0 +1,83 @@
+package incapsula
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceAccountApiKeys() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceAccountApiKeysRead,
+
+		Schema: map[string]*schema.Schema{
+			// Optional Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account or sub-account of the API keys. If not specified, the account of the API credentials.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+
+			// Computed Attributes
+			"api_keys": {
+				Description: "The API keys of the account, without the keys.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"expiration_date": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceAccountApiKeysRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+	accountID := d.Get("account_id").(int)
+
+	responseDTO, err := client.ListAccountApiKeys(accountID)
+	if err != nil {
+		return diag.Errorf("Error listing the API keys of account %d: %s", accountID, err)
+	}
+	if len(responseDTO.Errors) > 0 {
+		return diag.Errorf("Error listing the API keys of account %d: %s", accountID, formatApiErrors(responseDTO.Errors))
+	}
+
+	apiKeys := make([]interface{}, 0, len(responseDTO.Data))
+	for _, apiKey := range responseDTO.Data {
+		apiKeys = append(apiKeys, map[string]interface{}{
+			"api_id":          strconv.Itoa(apiKey.ID),
+			"name":            apiKey.Name,
+			"description":     apiKey.Description,
+			"status":          apiKey.Status,
+			"expiration_date": apiKey.ExpirationDate,
+		})
+	}
+	d.Set("api_keys", apiKeys)
+	d.SetId(strconv.Itoa(accountID))
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package incapsula
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceApiSecurityDiscoveredApis() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceApiSecurityDiscoveredApisRead,
+		Description: "Provides the APIs and endpoints discovered automatically by API Security on a site.",
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"site_id": {
+				Description: "Numeric identifier of the site.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+
+			// Optional Arguments
+			"filter_by_host_name": {
+				Description: "Filter by API host name",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+
+			// Computed Attributes
+			"apis": {
+				Description: "The discovered APIs.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The discovered API ID.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"host_name": {
+							Description: "The host name of the API.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"base_path": {
+							Description: "The base path of the API.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"first_seen": {
+							Description: "The time the API was first seen, in milliseconds since epoch.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"endpoints": {
+							Description: "The discovered endpoints of the API.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Description: "The \"METHOD path\" key of the endpoint.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"method": {
+										Description: "The HTTP method of the endpoint.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"path": {
+										Description: "The URL path of the endpoint.",
+										Type:        schema.TypeString,
+										Computed:    true,
+									},
+									"first_seen": {
+										Description: "The time the endpoint was first seen, in milliseconds since epoch.",
+										Type:        schema.TypeInt,
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"specification": {
+							Description: "The API exported as an OAS3 document, to be used as the api_specification of an incapsula_api_security_api_config.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceApiSecurityDiscoveredApisRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	siteID := d.Get("site_id").(int)
+	discoveredApisGetResponse, err := client.GetApiSecurityDiscoveredApis(siteID)
+	if err != nil {
+		return diag.Errorf("Error getting API Security discovered APIs for site (%d): %s", siteID, err)
+	}
+
+	apis := make([]interface{}, 0, len(discoveredApisGetResponse.Value))
+	for i, api := range discoveredApisGetResponse.Value {
+		if v, ok := d.GetOk("filter_by_host_name"); ok && v != api.HostName {
+			continue
+		}
+
+		endpoints := make([]interface{}, 0, len(api.Endpoints))
+		for _, endpoint := range api.Endpoints {
+			endpoints = append(endpoints, map[string]interface{}{
+				"key":        apiSecurityEndpointKey(endpoint.Method, endpoint.Path),
+				"method":     endpoint.Method,
+				"path":       endpoint.Path,
+				"first_seen": endpoint.FirstSeen,
+			})
+		}
+
+		specification, err := exportApiSecurityDiscoveredApi(&discoveredApisGetResponse.Value[i])
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		apis = append(apis, map[string]interface{}{
+			"id":            api.Id,
+			"host_name":     api.HostName,
+			"base_path":     api.BasePath,
+			"first_seen":    api.FirstSeen,
+			"endpoints":     endpoints,
+			"specification": specification,
+		})
+	}
+
+	d.SetId(strconv.Itoa(siteID))
+	d.Set("apis", apis)
+
+	return nil
+}
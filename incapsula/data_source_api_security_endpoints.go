@@ -0,0 +1,67 @@
+package incapsula
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceApiSecurityEndpoints() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceApiSecurityEndpointsRead,
+		Description: "Provides the endpoints of an API Security API configuration and their violation actions.",
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"api_id": {
+				Description: "The API Security API configuration ID.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"site_id": {
+				Description: "The site ID of the API. Used to key the endpoints by their path relative to the base path, as in the endpoint_keys of the API configuration.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+
+			// Computed Attributes
+			"endpoints": apiSecurityEndpointsSchema("The endpoints of the API."),
+			"ids": {
+				Description: "The endpoint IDs, keyed by \"METHOD path\".",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceApiSecurityEndpointsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	apiID := d.Get("api_id").(int)
+	endpointsGetAllResponse, err := client.GetApiSecurityAllEndpointsConfig(apiID)
+	if err != nil {
+		return diag.Errorf("Error getting API Security endpoints for API ID (%d): %s", apiID, err)
+	}
+	keys, err := getApiSecurityEndpointKeys(client, d.Get("site_id").(int), apiID)
+	if err != nil {
+		return diag.Errorf("Error getting API Security API configuration for API ID (%d): %s", apiID, err)
+	}
+
+	endpoints := make([]interface{}, 0, len(endpointsGetAllResponse.Value))
+	ids := make(map[string]string, len(endpointsGetAllResponse.Value))
+	for _, endpoint := range endpointsGetAllResponse.Value {
+		key := keys.key(endpoint.Method, endpoint.Path)
+		endpoints = append(endpoints, flattenApiSecurityEndpoint(endpoint, key))
+		ids[key] = strconv.Itoa(endpoint.Id)
+	}
+
+	d.SetId(strconv.Itoa(apiID))
+	d.Set("endpoints", endpoints)
+	d.Set("ids", ids)
+
+	return nil
+}
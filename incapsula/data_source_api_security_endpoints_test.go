@@ -0,0 +1,38 @@
+package incapsula
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const dataSourceApiSecurityEndpointsName = "data.incapsula_api_security_endpoints.testacc-terraform-api-security-endpoints"
+
+func TestAccIncapsulaDataSourceApiSecurityEndpoints_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaDataSourceApiSecurityEndpointsConfigBasic(t),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceApiSecurityEndpointsName, "endpoints.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceApiSecurityEndpointsName, "endpoints.0.key", "GET /users"),
+					resource.TestCheckResourceAttr(dataSourceApiSecurityEndpointsName, "endpoints.0.method", "GET"),
+					resource.TestCheckResourceAttr(dataSourceApiSecurityEndpointsName, "endpoints.0.path", "/users"),
+					resource.TestCheckResourceAttrPair(dataSourceApiSecurityEndpointsName, "ids.GET /users", apiSecApiConfigResource, "endpoints.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIncapsulaDataSourceApiSecurityEndpointsConfigBasic(t *testing.T) string {
+	return testAccCheckApiConfigBasic(t) + fmt.Sprintf(`
+data "incapsula_api_security_endpoints" "testacc-terraform-api-security-endpoints" {
+  api_id  = %s.id
+  site_id = %s.site_id
+}`, apiSecApiConfigResource, apiSecApiConfigResource,
+	)
+}
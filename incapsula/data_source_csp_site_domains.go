@@ -0,0 +1,144 @@
+package incapsula
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourceCSPSiteDomains() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceCSPSiteDomainsRead,
+		Description: "Provides the domains discovered by Client-Side Protection on a site.",
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"site_id": {
+				Description: "Numeric identifier of the site.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+
+			// Optional Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account of the site.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"status": {
+				Description:  "Filter by domain status. Values: allowed, blocked",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{cspDomainStatusAllowed, cspDomainStatusBlocked}, false),
+			},
+
+			// Computed Attributes
+			"domains": {
+				Description: "The discovered domains.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The domain reference ID.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"domain": {
+							Description: "The domain name.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"status": {
+							Description: "The domain status: allowed or blocked.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"reviewed": {
+							Description: "Whether the domain was reviewed.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"risk_tags": {
+							Description: "The risk tags of the domain.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"first_seen": {
+							Description: "The time the domain was first seen, in milliseconds since epoch.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"last_seen": {
+							Description: "The time the domain was last seen, in milliseconds since epoch.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"resource_types": {
+							Description: "The types of the resources loaded from the domain, e.g. script, image.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"domain_names": {
+				Description: "The names of the discovered domains.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// getCSPDiscoveredDomainStatus returns allowed or blocked, a domain without status is allowed
+func getCSPDiscoveredDomainStatus(domain *CSPDiscoveredDomain) string {
+	if domain.Status.Blocked != nil && *domain.Status.Blocked {
+		return cspDomainStatusBlocked
+	}
+	return cspDomainStatusAllowed
+}
+
+func dataSourceCSPSiteDomainsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	accountID := d.Get("account_id").(int)
+	siteID := d.Get("site_id").(int)
+	cspDomains, err := client.getCSPDomains(accountID, siteID)
+	if err != nil {
+		return diag.Errorf("Error getting CSP discovered domains for site (%d): %s", siteID, err)
+	}
+
+	domains := make([]interface{}, 0, len(cspDomains))
+	domainNames := make([]string, 0, len(cspDomains))
+	for i, cspDomain := range cspDomains {
+		status := getCSPDiscoveredDomainStatus(&cspDomains[i])
+		if v, ok := d.GetOk("status"); ok && v != status {
+			continue
+		}
+
+		domains = append(domains, map[string]interface{}{
+			"id":             cspDomain.ID,
+			"domain":         cspDomain.Domain,
+			"status":         status,
+			"reviewed":       cspDomain.Status.Reviewed != nil && *cspDomain.Status.Reviewed,
+			"risk_tags":      cspDomain.RiskTags,
+			"first_seen":     cspDomain.FirstSeen,
+			"last_seen":      cspDomain.LastSeen,
+			"resource_types": cspDomain.ResourceTypes,
+		})
+		domainNames = append(domainNames, cspDomain.Domain)
+	}
+
+	d.SetId(fmt.Sprintf("%d/%s", siteID, d.Get("status").(string)))
+	d.Set("domains", domains)
+	d.Set("domain_names", domainNames)
+
+	return nil
+}
@@ -0,0 +1,100 @@
+package incapsula
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNotificationCenterAssetTypes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNotificationCenterAssetTypesRead,
+		Description: "Provides the notification asset types available to the account, and the sub categories supporting them.",
+
+		Schema: map[string]*schema.Schema{
+			// Optional Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account. Defaults to the account of the API credentials.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"sub_category": {
+				Description: "Only list the asset types supported by this sub category.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+
+			// Computed Attributes
+			"asset_types": {
+				Description: "The notification asset types.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The asset type, to be used as asset_type of incapsula_notification_center_policy.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"sub_categories": {
+							Description: "The sub categories supporting the asset type.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"names": {
+				Description: "The names of the notification asset types.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceNotificationCenterAssetTypesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	accountID := d.Get("account_id").(int)
+	subCategoriesResponse, err := client.GetNotificationCenterSubCategories(accountID)
+	if err != nil {
+		return diag.Errorf("Error getting notification sub categories for account (%d): %s", accountID, err)
+	}
+
+	subCategoriesByAssetType := map[string][]string{}
+	filter := d.Get("sub_category").(string)
+	for _, subCategory := range subCategoriesResponse.Data {
+		if filter != "" && filter != subCategory.SubCategory {
+			continue
+		}
+		for _, assetType := range subCategory.AssetTypes {
+			subCategoriesByAssetType[assetType] = append(subCategoriesByAssetType[assetType], subCategory.SubCategory)
+		}
+	}
+
+	names := make([]string, 0, len(subCategoriesByAssetType))
+	for assetType := range subCategoriesByAssetType {
+		names = append(names, assetType)
+	}
+	sort.Strings(names)
+
+	assetTypes := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		assetTypes = append(assetTypes, map[string]interface{}{
+			"name":           name,
+			"sub_categories": subCategoriesByAssetType[name],
+		})
+	}
+
+	d.SetId(fmt.Sprintf("%d/%s", accountID, filter))
+	d.Set("asset_types", assetTypes)
+	d.Set("names", names)
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package incapsula
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceNotificationCenterSubCategories() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceNotificationCenterSubCategoriesRead,
+		Description: "Provides the notification sub categories available to the account, and the asset types they support.",
+
+		Schema: map[string]*schema.Schema{
+			// Optional Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account. Defaults to the account of the API credentials.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+
+			// Computed Attributes
+			"sub_categories": {
+				Description: "The notification sub categories.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The sub category, to be used as sub_category of incapsula_notification_center_policy.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"display_name": {
+							Description: "The display name of the sub category.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"category": {
+							Description: "The category of the sub category.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"asset_types": {
+							Description: "The asset types supported by the sub category. Empty when the sub category does not support assets.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"names": {
+				Description: "The names of the notification sub categories.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceNotificationCenterSubCategoriesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	accountID := d.Get("account_id").(int)
+	subCategoriesResponse, err := client.GetNotificationCenterSubCategories(accountID)
+	if err != nil {
+		return diag.Errorf("Error getting notification sub categories for account (%d): %s", accountID, err)
+	}
+
+	subCategories := make([]interface{}, 0, len(subCategoriesResponse.Data))
+	names := make([]string, 0, len(subCategoriesResponse.Data))
+	for _, subCategory := range subCategoriesResponse.Data {
+		subCategories = append(subCategories, map[string]interface{}{
+			"name":         subCategory.SubCategory,
+			"display_name": subCategory.DisplayName,
+			"category":     subCategory.Category,
+			"asset_types":  subCategory.AssetTypes,
+		})
+		names = append(names, subCategory.SubCategory)
+	}
+
+	d.SetId(strconv.Itoa(accountID))
+	d.Set("sub_categories", subCategories)
+	d.Set("names", names)
+
+	return nil
+}
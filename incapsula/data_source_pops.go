@@ -0,0 +1,126 @@
+package incapsula
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func dataSourcePops() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourcePopsRead,
+		Description: "Provides the Imperva PoPs, and the origin PoP nearest to a region.",
+
+		Schema: map[string]*schema.Schema{
+			// Optional Arguments
+			"region": {
+				Description:  "Only list the PoPs of this region, and set nearest_origin_pop to the origin PoP nearest to it. One of the data center geo_locations.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice(dataCenterGeoLocations, false),
+			},
+			"origin_pop_only": {
+				Description: "Only list the PoPs which can be used as origin PoP.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+			},
+
+			// Computed Attributes
+			"pops": {
+				Description: "The PoPs, sorted by code.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"code": {
+							Description: "The PoP code, e.g. lax.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"city": {
+							Description: "The city of the PoP.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"country": {
+							Description: "The country of the PoP.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"region": {
+							Description: "The region of the PoP.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"supports_origin_pop": {
+							Description: "Whether the PoP can be used as origin PoP.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"codes": {
+				Description: "The codes of the PoPs.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"nearest_origin_pop": {
+				Description: "The code of the origin PoP nearest to the region. Only set when region is set.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourcePopsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	client := m.(*Client)
+
+	popsDTO, err := client.GetPops()
+	if err != nil {
+		return diag.Errorf("Error getting PoPs: %s", err)
+	}
+
+	region := d.Get("region").(string)
+	originPopOnly := d.Get("origin_pop_only").(bool)
+
+	allPops := append([]Pop{}, popsDTO.Data...)
+	sort.Slice(allPops, func(i, j int) bool { return allPops[i].Code < allPops[j].Code })
+
+	pops := make([]interface{}, 0, len(allPops))
+	codes := make([]string, 0, len(allPops))
+	for _, pop := range allPops {
+		if (region != "" && pop.Region != region) || (originPopOnly && !pop.SupportsOriginPop) {
+			continue
+		}
+		pops = append(pops, map[string]interface{}{
+			"code":                pop.Code,
+			"city":                pop.City,
+			"country":             pop.Country,
+			"region":              pop.Region,
+			"supports_origin_pop": pop.SupportsOriginPop,
+		})
+		codes = append(codes, pop.Code)
+	}
+
+	nearestOriginPop := ""
+	if region != "" {
+		nearest, err := getNearestOriginPop(allPops, region)
+		if err != nil {
+			return diag.Errorf("Error getting the origin PoP nearest to region %s: %s", region, err)
+		}
+		nearestOriginPop = nearest.Code
+	}
+
+	d.SetId("pops/" + region)
+	d.Set("pops", pops)
+	d.Set("codes", codes)
+	d.Set("nearest_origin_pop", nearestOriginPop)
+
+	return nil
+}
@@ -2,19 +2,59 @@ package incapsula
 
 import (
 	"context"
+	"fmt"
 	"strconv"
-	"time"
+	"strings"
+	"unicode"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// Categories of the abilities
+const (
+	abilityCategorySite         = "site"
+	abilityCategoryPolicy       = "policy"
+	abilityCategoryCertificates = "certificates"
+	abilityCategoryAccount      = "account"
+)
+
+// Named attributes of the data source, set to the key of the ability, e.g. can_add_site is canAddSite
+var roleAbilitiesAttributes = []string{
+	"can_add_site", "can_edit_site", "can_edit_account", "can_add_user", "can_manage_api_key",
+	"can_manage_account_sub_accounts", "can_edit_domain", "can_add_domain", "can_view_infra_protect_setting",
+	"can_run_connectivity_reports", "can_purge_cache", "can_edit_single_ip", "can_edit_roles", "can_view_audit_trail",
+	"can_view_client_certificates", "can_view_policy", "can_assign_client_certificates", "can_delete_policy_exception",
+	"can_delete_policy", "can_add_policy", "can_edit_client_certificates", "can_edit_policy", "can_edit_policy_exception",
+	"can_apply_policy_to_assets", "can_add_policy_exception",
+}
+
 func dataSourceRoleAbilities() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataSourceRoleAbilitiesRead,
 
 		Schema: map[string]*schema.Schema{
+			// Optional Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account of the abilities. If not specified, the account of the API credentials.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"category": {
+				Description:  "Only list the abilities of a category in abilities, guessed from the ability key. One of: site, policy, certificates, account.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{abilityCategorySite, abilityCategoryPolicy, abilityCategoryCertificates, abilityCategoryAccount}, false),
+			},
+
 			// Computed Attributes
+			"abilities": {
+				Description: "The display names of the abilities by ability key, e.g. canAddSite = Add sites.",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
 			"can_add_site": {
 				Description: "Add sites",
 				Type:        schema.TypeString,
@@ -145,35 +185,77 @@ func dataSourceRoleAbilities() *schema.Resource {
 }
 
 func dataSourceRoleAbilitiesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	// Generate ID
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
-
-	// Populate values (doing this to improve TF usability)
-	d.Set("can_add_site", "canAddSite")
-	d.Set("can_edit_site", "canEditSite")
-	d.Set("can_edit_account", "canEditAccount")
-	d.Set("can_add_user", "canAddUser")
-	d.Set("can_manage_api_key", "canManageApiKey")
-	d.Set("can_manage_account_sub_accounts", "canManageAccountSubAccounts")
-	d.Set("can_edit_domain", "canEditDomain")
-	d.Set("can_add_domain", "canAddDomain")
-	d.Set("can_view_infra_protect_setting", "canViewInfraProtectSetting")
-	d.Set("can_run_connectivity_reports", "canRunConnectivityReports")
-	d.Set("can_purge_cache", "canPurgeCache")
-	d.Set("can_edit_single_ip", "canEditcanEditSingleIpSite")
-	d.Set("can_edit_roles", "canEditRoles")
-	d.Set("can_view_audit_trail", "canViewAuditTrail")
-	d.Set("can_view_client_certificates", "canViewClientCertificates")
-	d.Set("can_view_policy", "canViewPolicy")
-	d.Set("can_assign_client_certificates", "canAssignClientCertificates")
-	d.Set("can_delete_policy_exception", "canDeletePolicyException")
-	d.Set("can_delete_policy", "canDeletePolicy")
-	d.Set("can_add_policy", "canAddPolicy")
-	d.Set("can_edit_client_certificates", "canEditClientCertificates")
-	d.Set("can_edit_policy", "canEditPolicy")
-	d.Set("can_edit_policy_exception", "canEditPolicyException")
-	d.Set("can_apply_policy_to_assets", "canApplyPolicyToAssets")
-	d.Set("can_add_policy_exception", "canAddPolicyException")
-
-	return nil
+	client := m.(*Client)
+	accountID := d.Get("account_id").(int)
+	category := d.Get("category").(string)
+
+	abilities, err := client.GetAccountAbilities(accountID)
+	if err != nil {
+		return diag.Errorf("Error getting the abilities of account %d: %s", accountID, err)
+	}
+
+	keys := map[string]bool{}
+	displayNames := map[string]string{}
+	for _, ability := range abilities {
+		keys[ability.AbilityKey] = true
+		if category == "" || getAbilityCategory(ability.AbilityKey) == category {
+			displayNames[ability.AbilityKey] = ability.AbilityDisplayName
+		}
+	}
+
+	// The named attributes are not filtered by category, the abilities which are not available to the account are reported
+	missingKeys := []string{}
+	for _, attribute := range roleAbilitiesAttributes {
+		key := getAbilityKey(attribute)
+		if !keys[key] {
+			missingKeys = append(missingKeys, key)
+		}
+		d.Set(attribute, key)
+	}
+	d.Set("abilities", displayNames)
+
+	id := strconv.Itoa(accountID)
+	if category != "" {
+		id = fmt.Sprintf("%s/%s", id, category)
+	}
+	d.SetId(id)
+
+	if len(missingKeys) == 0 {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Abilities not available to account %d", accountID),
+		Detail:   fmt.Sprintf("The abilities %s are not available to account %d.", strings.Join(missingKeys, ", "), accountID),
+	}}
+}
+
+// getAbilityKey returns the key of the ability of a named attribute, e.g. canAddSite for can_add_site
+func getAbilityKey(attribute string) string {
+	words := strings.Split(attribute, "_")
+	for i := 1; i < len(words); i++ {
+		runes := []rune(words[i])
+		if len(runes) > 0 {
+			runes[0] = unicode.ToUpper(runes[0])
+		}
+		words[i] = string(runes)
+	}
+
+	return strings.Join(words, "")
+}
+
+// getAbilityCategory guesses the category of an ability from its key, as the API doesn't return it: abilities which
+// are not about policies, certificates or the account are site abilities. New abilities may be misclassified.
+func getAbilityCategory(key string) string {
+	switch {
+	case strings.Contains(key, "Policy"):
+		return abilityCategoryPolicy
+	case strings.Contains(key, "Certificate"):
+		return abilityCategoryCertificates
+	case strings.Contains(key, "Account"), strings.Contains(key, "User"), strings.Contains(key, "ApiKey"),
+		strings.Contains(key, "Role"), strings.Contains(key, "AuditTrail"):
+		return abilityCategoryAccount
+	default:
+		return abilityCategorySite
+	}
 }
@@ -0,0 +1,81 @@
+package incapsula
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestGetAbilityKey(t *testing.T) {
+	cases := map[string]string{
+		"can_add_site":                    "canAddSite",
+		"can_edit_single_ip":              "canEditSingleIp",
+		"can_manage_account_sub_accounts": "canManageAccountSubAccounts",
+		"can_view_infra_protect_setting":  "canViewInfraProtectSetting",
+	}
+
+	for attribute, expected := range cases {
+		if key := getAbilityKey(attribute); key != expected {
+			t.Errorf("%s: expected %s, got: %s", attribute, expected, key)
+		}
+	}
+}
+
+func TestGetAbilityCategory(t *testing.T) {
+	cases := map[string]string{
+		"canAddSite":                  abilityCategorySite,
+		"canPurgeCache":               abilityCategorySite,
+		"canApplyPolicyToAssets":      abilityCategoryPolicy,
+		"canViewClientCertificates":   abilityCategoryCertificates,
+		"canManageAccountSubAccounts": abilityCategoryAccount,
+		"canViewAuditTrail":           abilityCategoryAccount,
+	}
+
+	for key, expected := range cases {
+		if category := getAbilityCategory(key); category != expected {
+			t.Errorf("%s: expected %s, got: %s", key, expected, category)
+		}
+	}
+}
+
+func TestDataSourceRoleAbilitiesRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		if req.URL.String() != fmt.Sprintf("/%s?caid=123", endpointUserManagementAbilities) {
+			t.Errorf("Should have have hit /%s?caid=123 endpoint. Got: %s", endpointUserManagementAbilities, req.URL.String())
+		}
+		rw.Write([]byte(`[{"abilityKey":"canAddSite","abilityDisplayName":"Add sites"},{"abilityKey":"canEditPolicy","abilityDisplayName":"Edit policy"}]`))
+	}))
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+	d := schema.TestResourceDataRaw(t, dataSourceRoleAbilities().Schema, map[string]interface{}{
+		"account_id": 123,
+		"category":   "site",
+	})
+
+	diags := dataSourceRoleAbilitiesRead(context.Background(), d, client)
+	if diags.HasError() {
+		t.Fatalf("Should not have received an error, got: %v", diags)
+	}
+	if len(diags) != 1 || !strings.Contains(diags[0].Detail, "canAddUser") || strings.Contains(diags[0].Detail, "canAddSite") {
+		t.Errorf("Expected a warning about the abilities which are not available, got: %v", diags)
+	}
+	if d.Id() != "123/site" {
+		t.Errorf("Expected ID 123/site, got: %s", d.Id())
+	}
+	if abilities := d.Get("abilities").(map[string]interface{}); fmt.Sprint(abilities) != "map[canAddSite:Add sites]" {
+		t.Errorf("Expected the site abilities only, got: %v", abilities)
+	}
+	if d.Get("can_edit_policy").(string) != "canEditPolicy" || d.Get("can_add_site").(string) != "canAddSite" {
+		t.Errorf("Expected the named attributes of the abilities of the account, got: %s, %s", d.Get("can_edit_policy"), d.Get("can_add_site"))
+	}
+	if d.Get("can_add_user").(string) != "canAddUser" {
+		t.Errorf("Expected the key of an ability which is not available, got: %s", d.Get("can_add_user"))
+	}
+}
@@ -50,3 +50,29 @@ func suppressEquivalentJSONStringDiffs(k, old, new string, d *schema.ResourceDat
 
 	return reflect.DeepEqual(o1, o2)
 }
+
+func suppressEquivalentIncapRuleFilterDiffs(k, old, new string, d *schema.ResourceData) bool {
+	oldFilter, _, err := parseIncapRuleFilter(old)
+	if err != nil {
+		return old == new
+	}
+	newFilter, _, err := parseIncapRuleFilter(new)
+	if err != nil {
+		return old == new
+	}
+
+	return oldFilter.String() == newFilter.String()
+}
+
+func suppressEquivalentApiSpecificationDiffs(k, old, new string, d *schema.ResourceData) bool {
+	oldSpecification, err := normalizeApiSpecification(old)
+	if err != nil {
+		return old == new
+	}
+	newSpecification, err := normalizeApiSpecification(new)
+	if err != nil {
+		return old == new
+	}
+
+	return oldSpecification == newSpecification
+}
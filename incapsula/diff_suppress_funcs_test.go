@@ -30,3 +30,44 @@ func TestSuppressEquivalentStringDiffsSameStringsDifferent(t *testing.T) {
 		t.Errorf("Should not be equivalent")
 	}
 }
+
+func TestSuppressEquivalentIncapRuleFilterDiffsFormatting(t *testing.T) {
+	old := "Full-URL == \"/someurl\" & isMobile == \"Yes\""
+	new := "full-url==/someurl&isMobile == Yes"
+
+	if !suppressEquivalentIncapRuleFilterDiffs("", old, new, nil) {
+		t.Errorf("Should be equivalent")
+	}
+}
+
+func TestSuppressEquivalentIncapRuleFilterDiffsDifferent(t *testing.T) {
+	old := "Full-URL == \"/someurl\""
+	new := "Full-URL == \"/otherurl\""
+
+	if suppressEquivalentIncapRuleFilterDiffs("", old, new, nil) {
+		t.Errorf("Should not be equivalent")
+	}
+}
+
+func TestSuppressEquivalentIncapRuleFilterDiffsInvalid(t *testing.T) {
+	old := "Full-URL == \"/someurl\""
+	new := "Full-URL == \"/someurl"
+
+	if suppressEquivalentIncapRuleFilterDiffs("", old, new, nil) {
+		t.Errorf("Should not be equivalent")
+	}
+}
+
+func TestSuppressEquivalentApiSpecificationDiffsYAMLAndJSON(t *testing.T) {
+	if !suppressEquivalentApiSpecificationDiffs("", testApiSpecificationOAS2JSON, testApiSpecificationOAS2YAML, nil) {
+		t.Errorf("Should be equivalent")
+	}
+}
+
+func TestSuppressEquivalentApiSpecificationDiffsDifferent(t *testing.T) {
+	new := testApiSpecificationOAS2YAML + "schemes:\n  - https\n"
+
+	if suppressEquivalentApiSpecificationDiffs("", testApiSpecificationOAS2YAML, new, nil) {
+		t.Errorf("Should not be equivalent")
+	}
+}
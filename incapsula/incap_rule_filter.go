@@ -0,0 +1,367 @@
+package incapsula
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Known predicates of an incap rule filter, keyed by their lower case name.
+// Other predicates are accepted with a warning, the API may support predicates which are not listed here.
+var incapRuleFilterPredicates = map[string]string{
+	"asn":          "ASN",
+	"clientid":     "ClientId",
+	"clientip":     "ClientIP",
+	"clienttype":   "ClientType",
+	"cookieexists": "CookieExists",
+	"cookievalue":  "CookieValue",
+	"countrycode":  "CountryCode",
+	"full-url":     "Full-URL",
+	"headerexists": "HeaderExists",
+	"headervalue":  "HeaderValue",
+	"ismobile":     "isMobile",
+	"method":       "Method",
+	"paramexists":  "ParamExists",
+	"paramvalue":   "ParamValue",
+	"protocol":     "Protocol",
+	"querystring":  "QueryString",
+	"referrer":     "Referrer",
+	"url":          "URL",
+	"useragent":    "UserAgent",
+}
+
+// Known operators between a predicate and its values, the ^ operators are case insensitive.
+// Other operators are accepted with a warning.
+var incapRuleFilterOperators = []string{"==", "!=", ">=", "<=", ">", "<", "contains", "not-contains", "^==", "^!=", "^contains", "^not-contains"}
+
+// incapRuleFilterCondition is a single "predicate[(argument[,argument...])] operator value[;value...]" condition
+type incapRuleFilterCondition struct {
+	Predicate string
+	Arguments []string
+	Operator  string
+	Values    []string
+}
+
+// incapRuleFilterNode is either a condition or a parenthesized group of nodes joined by & and |
+type incapRuleFilterNode struct {
+	Condition *incapRuleFilterCondition
+	Group     *incapRuleFilterExpression
+}
+
+// incapRuleFilterExpression is a list of nodes, Operators[i] joins Nodes[i] and Nodes[i+1]
+type incapRuleFilterExpression struct {
+	Nodes     []incapRuleFilterNode
+	Operators []string
+}
+
+// IncapRuleFilterError reports a syntax error and its position (1-based) in the filter
+type IncapRuleFilterError struct {
+	Position int
+	Message  string
+}
+
+func (e *IncapRuleFilterError) Error() string {
+	return fmt.Sprintf("invalid filter at position %d: %s", e.Position, e.Message)
+}
+
+type incapRuleFilterParser struct {
+	input    string
+	pos      int
+	warnings []string
+}
+
+// parseIncapRuleFilter parses an incap rule filter, an empty filter parses to an empty expression.
+// Only syntax errors fail, unknown predicates and operators are returned as warnings.
+func parseIncapRuleFilter(filter string) (*incapRuleFilterExpression, []string, error) {
+	p := &incapRuleFilterParser{input: filter}
+
+	p.skipSpaces()
+	if p.pos == len(p.input) {
+		return &incapRuleFilterExpression{}, nil, nil
+	}
+
+	expression, err := p.parseExpression()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	p.skipSpaces()
+	if p.pos < len(p.input) {
+		if p.input[p.pos] == ')' {
+			return nil, nil, p.errorf("unbalanced parenthesis")
+		}
+		return nil, nil, p.errorf("expected & or |, got %q", p.input[p.pos:p.pos+1])
+	}
+
+	return expression, p.warnings, nil
+}
+
+func (p *incapRuleFilterParser) warnf(position int, format string, a ...interface{}) {
+	p.warnings = append(p.warnings, fmt.Sprintf("position %d: %s", position+1, fmt.Sprintf(format, a...)))
+}
+
+func (p *incapRuleFilterParser) errorf(format string, a ...interface{}) error {
+	return &IncapRuleFilterError{Position: p.pos + 1, Message: fmt.Sprintf(format, a...)}
+}
+
+func (p *incapRuleFilterParser) skipSpaces() {
+	for p.pos < len(p.input) && strings.ContainsRune(" \t\r\n", rune(p.input[p.pos])) {
+		p.pos++
+	}
+}
+
+func (p *incapRuleFilterParser) parseExpression() (*incapRuleFilterExpression, error) {
+	expression := &incapRuleFilterExpression{}
+
+	for {
+		node, err := p.parseNode()
+		if err != nil {
+			return nil, err
+		}
+		expression.Nodes = append(expression.Nodes, *node)
+
+		p.skipSpaces()
+		if p.pos == len(p.input) || (p.input[p.pos] != '&' && p.input[p.pos] != '|') {
+			return expression, nil
+		}
+		expression.Operators = append(expression.Operators, p.input[p.pos:p.pos+1])
+		p.pos++
+	}
+}
+
+func (p *incapRuleFilterParser) parseNode() (*incapRuleFilterNode, error) {
+	p.skipSpaces()
+	if p.pos == len(p.input) {
+		return nil, p.errorf("expected a condition, got end of filter")
+	}
+
+	if p.input[p.pos] != '(' {
+		condition, err := p.parseCondition()
+		if err != nil {
+			return nil, err
+		}
+		return &incapRuleFilterNode{Condition: condition}, nil
+	}
+
+	open := p.pos
+	p.pos++
+	group, err := p.parseExpression()
+	if err != nil {
+		return nil, err
+	}
+
+	p.skipSpaces()
+	if p.pos == len(p.input) || p.input[p.pos] != ')' {
+		p.pos = open
+		return nil, p.errorf("unbalanced parenthesis")
+	}
+	p.pos++
+
+	return &incapRuleFilterNode{Group: group}, nil
+}
+
+func (p *incapRuleFilterParser) parseCondition() (*incapRuleFilterCondition, error) {
+	// Predicate
+	start := p.pos
+	for p.pos < len(p.input) && isIncapRuleFilterNameChar(p.input[p.pos]) {
+		p.pos++
+	}
+	if start == p.pos {
+		return nil, p.errorf("expected a predicate, got %q", p.input[p.pos:p.pos+1])
+	}
+	name := p.input[start:p.pos]
+	predicate, ok := incapRuleFilterPredicates[strings.ToLower(name)]
+	if !ok {
+		predicate = name
+		p.warnf(start, "unknown predicate %q, it can't be validated by the provider", name)
+	}
+	condition := &incapRuleFilterCondition{Predicate: predicate}
+
+	// Arguments, e.g. the header name of HeaderValue("X-Forwarded-For")
+	p.skipSpaces()
+	if p.pos < len(p.input) && p.input[p.pos] == '(' {
+		arguments, err := p.parseArguments()
+		if err != nil {
+			return nil, err
+		}
+		condition.Arguments = arguments
+	}
+
+	// Operator, optionally prefixed by ^ for case insensitive operators
+	p.skipSpaces()
+	start = p.pos
+	if p.pos < len(p.input) && p.input[p.pos] == '^' {
+		p.pos++
+	}
+	switch {
+	case p.pos < len(p.input) && strings.ContainsRune("=!<>~", rune(p.input[p.pos])):
+		for p.pos < len(p.input) && strings.ContainsRune("=!<>~", rune(p.input[p.pos])) {
+			p.pos++
+		}
+	case p.pos < len(p.input) && isIncapRuleFilterNameChar(p.input[p.pos]):
+		for p.pos < len(p.input) && isIncapRuleFilterNameChar(p.input[p.pos]) {
+			p.pos++
+		}
+	}
+	if start == p.pos || p.input[p.pos-1] == '^' {
+		p.pos = start
+		if p.pos == len(p.input) {
+			return nil, p.errorf("expected an operator after %s, got end of filter", predicate)
+		}
+		return nil, p.errorf("expected an operator after %s (one of %s)", predicate, strings.Join(incapRuleFilterOperators, ", "))
+	}
+	condition.Operator = strings.ToLower(p.input[start:p.pos])
+	if !isIncapRuleFilterOperator(condition.Operator) {
+		condition.Operator = p.input[start:p.pos]
+		p.warnf(start, "unknown operator %q, it can't be validated by the provider", condition.Operator)
+	}
+
+	// Values, separated by ;
+	for {
+		value, err := p.parseValue("")
+		if err != nil {
+			return nil, err
+		}
+		condition.Values = append(condition.Values, value)
+
+		p.skipSpaces()
+		if p.pos == len(p.input) || p.input[p.pos] != ';' {
+			return condition, nil
+		}
+		p.pos++
+	}
+}
+
+// parseArguments parses the parenthesized arguments of a predicate, separated by ,
+func (p *incapRuleFilterParser) parseArguments() ([]string, error) {
+	open := p.pos
+	p.pos++
+
+	arguments := []string{}
+	for {
+		argument, err := p.parseValue(",")
+		if err != nil {
+			return nil, err
+		}
+		arguments = append(arguments, argument)
+
+		p.skipSpaces()
+		if p.pos == len(p.input) {
+			p.pos = open
+			return nil, p.errorf("unbalanced parenthesis")
+		}
+		switch p.input[p.pos] {
+		case ',':
+			p.pos++
+		case ')':
+			p.pos++
+			return arguments, nil
+		default:
+			return nil, p.errorf("expected , or ), got %q", p.input[p.pos:p.pos+1])
+		}
+	}
+}
+
+// parseValue parses a quoted or bare value, a bare value also ends at the separators
+func (p *incapRuleFilterParser) parseValue(separators string) (string, error) {
+	p.skipSpaces()
+	if p.pos == len(p.input) {
+		return "", p.errorf("expected a value, got end of filter")
+	}
+
+	// Quoted value, \" and \\ are escaped
+	if p.input[p.pos] == '"' {
+		open := p.pos
+		p.pos++
+		var value strings.Builder
+		for p.pos < len(p.input) {
+			ch := p.input[p.pos]
+			switch {
+			case ch == '\\' && p.pos+1 < len(p.input):
+				value.WriteByte(p.input[p.pos+1])
+				p.pos += 2
+			case ch == '"':
+				p.pos++
+				return value.String(), nil
+			default:
+				value.WriteByte(ch)
+				p.pos++
+			}
+		}
+		p.pos = open
+		return "", p.errorf("unterminated quoted value")
+	}
+
+	// Bare value
+	start := p.pos
+	for p.pos < len(p.input) && !strings.ContainsRune(" \t\r\n;&|()\""+separators, rune(p.input[p.pos])) {
+		p.pos++
+	}
+	if start == p.pos {
+		return "", p.errorf("expected a value, got %q", p.input[p.pos:p.pos+1])
+	}
+
+	return p.input[start:p.pos], nil
+}
+
+func isIncapRuleFilterOperator(operator string) bool {
+	for _, known := range incapRuleFilterOperators {
+		if operator == known {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isIncapRuleFilterNameChar(ch byte) bool {
+	return (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z') || (ch >= '0' && ch <= '9') || ch == '-' || ch == '_'
+}
+
+// String returns the canonical form of the filter: single spaces, quoted values and canonical predicate names
+func (e *incapRuleFilterExpression) String() string {
+	var sb strings.Builder
+	for i, node := range e.Nodes {
+		if i > 0 {
+			sb.WriteString(" " + e.Operators[i-1] + " ")
+		}
+		if node.Group != nil {
+			sb.WriteString("(" + node.Group.String() + ")")
+			continue
+		}
+
+		values := make([]string, 0, len(node.Condition.Values))
+		for _, value := range node.Condition.Values {
+			values = append(values, strconv.Quote(value))
+		}
+		sb.WriteString(node.Condition.Predicate)
+		if node.Condition.Arguments != nil {
+			arguments := make([]string, 0, len(node.Condition.Arguments))
+			for _, argument := range node.Condition.Arguments {
+				arguments = append(arguments, strconv.Quote(argument))
+			}
+			sb.WriteString("(" + strings.Join(arguments, ",") + ")")
+		}
+		sb.WriteString(" " + node.Condition.Operator + " " + strings.Join(values, ";"))
+	}
+
+	return sb.String()
+}
+
+func validateIncapRuleFilter(i interface{}, k string) (warnings []string, errors []error) {
+	filter, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return warnings, errors
+	}
+
+	_, filterWarnings, err := parseIncapRuleFilter(filter)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%s: %s", k, err))
+	}
+	for _, warning := range filterWarnings {
+		warnings = append(warnings, fmt.Sprintf("%s: %s", k, warning))
+	}
+
+	return warnings, errors
+}
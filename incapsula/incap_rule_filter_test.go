@@ -0,0 +1,110 @@
+package incapsula
+
+import (
+	"testing"
+)
+
+func TestParseIncapRuleFilterValid(t *testing.T) {
+	filters := map[string]string{
+		"":                                    "",
+		"Full-URL == \"/someurl\"":            "Full-URL == \"/someurl\"",
+		"isMobile == Yes":                     "isMobile == \"Yes\"",
+		"ParamExists == \"true\"":             "ParamExists == \"true\"",
+		"clientip==1.2.3.4;10.0.0.0/8":        "ClientIP == \"1.2.3.4\";\"10.0.0.0/8\"",
+		"URL contains \"/admin\"":             "URL contains \"/admin\"",
+		"URL NOT-CONTAINS /login":             "URL not-contains \"/login\"",
+		"ASN >= 100":                          "ASN >= \"100\"",
+		"UserAgent == \"a \\\"quoted\\\" b\"": "UserAgent == \"a \\\"quoted\\\" b\"",
+		"(CountryCode == US ; CA | ClientIP != 1.1.1.1-1.1.1.9)  &  Method == POST": "(CountryCode == \"US\";\"CA\" | ClientIP != \"1.1.1.1-1.1.1.9\") & Method == \"POST\"",
+		"Protocol == http": "Protocol == \"http\"",
+		"HeaderValue(\"X-Forwarded-For\") == 1.2.3.4": "HeaderValue(\"X-Forwarded-For\") == \"1.2.3.4\"",
+		"headervalue ( X-Custom , b ) != x":           "HeaderValue(\"X-Custom\",\"b\") != \"x\"",
+		"URL ^contains \"/Admin\"":                    "URL ^contains \"/Admin\"",
+		"UserAgent ^NOT-CONTAINS bot":                 "UserAgent ^not-contains \"bot\"",
+	}
+
+	for filter, canonical := range filters {
+		expression, warnings, err := parseIncapRuleFilter(filter)
+		if err != nil {
+			t.Errorf("Should have parsed filter %q, got: %s", filter, err)
+			continue
+		}
+		if len(warnings) != 0 {
+			t.Errorf("Should not have received warnings for filter %q, got: %v", filter, warnings)
+		}
+		if expression.String() != canonical {
+			t.Errorf("Canonical form of filter %q should be %q, got %q", filter, canonical, expression.String())
+		}
+	}
+}
+
+func TestParseIncapRuleFilterInvalid(t *testing.T) {
+	filters := map[string]int{
+		"URL":                              4,
+		"URL ==":                           7,
+		"URL ^ \"/a\"":                     5,
+		"== bar":                           1,
+		"HeaderValue(\"a\" == b":           17,
+		"URL == \"/a":                      8,
+		"(URL == \"/a\" & Method == GET":   1,
+		"URL == \"/a\")":                   12,
+		"URL == \"/a\" Method == GET":      13,
+		"URL == \"/a\" & ":                 15,
+		"URL == \"/a\" & (Method == GET;)": 30,
+	}
+
+	for filter, position := range filters {
+		_, _, err := parseIncapRuleFilter(filter)
+		if err == nil {
+			t.Errorf("Should have failed to parse filter %q", filter)
+			continue
+		}
+		filterErr, ok := err.(*IncapRuleFilterError)
+		if !ok {
+			t.Errorf("Should have returned an IncapRuleFilterError for filter %q, got: %s", filter, err)
+			continue
+		}
+		if filterErr.Position != position {
+			t.Errorf("Error position for filter %q should be %d, got %d (%s)", filter, position, filterErr.Position, err)
+		}
+	}
+}
+
+func TestParseIncapRuleFilterUnknown(t *testing.T) {
+	filters := map[string][2]string{
+		"Foo == bar":          {"Foo == \"bar\"", "position 1: unknown predicate \"Foo\", it can't be validated by the provider"},
+		"URL = \"/a\"":        {"URL = \"/a\"", "position 5: unknown operator \"=\", it can't be validated by the provider"},
+		"Method ^matches GET": {"Method ^matches \"GET\"", "position 8: unknown operator \"^matches\", it can't be validated by the provider"},
+	}
+
+	for filter, expected := range filters {
+		expression, warnings, err := parseIncapRuleFilter(filter)
+		if err != nil {
+			t.Errorf("Should have parsed filter %q, got: %s", filter, err)
+			continue
+		}
+		if expression.String() != expected[0] {
+			t.Errorf("Canonical form of filter %q should be %q, got %q", filter, expected[0], expression.String())
+		}
+		if len(warnings) != 1 || warnings[0] != expected[1] {
+			t.Errorf("Should have received warning %q for filter %q, got: %v", expected[1], filter, warnings)
+		}
+	}
+}
+
+func TestValidateIncapRuleFilter(t *testing.T) {
+	_, errors := validateIncapRuleFilter("Full-URL == \"/someurl\"", "filter")
+	if len(errors) != 0 {
+		t.Errorf("Should not have received errors, got: %v", errors)
+	}
+
+	_, errors = validateIncapRuleFilter("Full-URL == (", "filter")
+	if len(errors) != 1 {
+		t.Errorf("Should have received an error")
+	}
+
+	warnings, errors := validateIncapRuleFilter("Protocol == http & Foo == bar", "filter")
+	if len(errors) != 0 || len(warnings) != 1 {
+		t.Errorf("Should have received a warning and no errors, got: %v %v", warnings, errors)
+	}
+}
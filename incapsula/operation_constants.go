@@ -23,8 +23,30 @@ const DeleteAccount = "delete_account"
 
 const CreateSubAccount = "create_sub_account"
 const ReadSubAccount = "read_sub_account"
+const UpdateSubAccount = "update_sub_account"
 const DeleteSubAccount = "delete_sub_account"
 
+const CreateAccountRole = "create_account_role"
+const ReadAccountRole = "read_account_role"
+const UpdateAccountRole = "update_account_role"
+const DeleteAccountRole = "delete_account_role"
+
+const CreateAccountUser = "create_account_user"
+const ReadAccountUser = "read_account_user"
+const UpdateAccountUser = "update_account_user"
+const DeleteAccountUser = "delete_account_user"
+
+const ReadAccountAbilities = "read_account_abilities"
+
+const CreateAccountApiKey = "create_account_api_key"
+const ReadAccountApiKey = "read_account_api_key"
+const UpdateAccountApiKey = "update_account_api_key"
+const DeleteAccountApiKey = "delete_account_api_key"
+
+const ReadAccountSSLSettings = "read_account_ssl_settings"
+const UpdateAccountSSLSettings = "update_account_ssl_settings"
+const DeleteAccountSSLSettings = "delete_account_ssl_settings"
+
 const ReadAccountDataStorageRegion = "read_account_data_storage_region"
 const UpdateAccountDataStorageRegion = "update_account_data_storage_region"
 
@@ -47,6 +69,8 @@ const UpdateApiSecEndpointConfig = "update_api_sec_endpoint_config"
 const ReadApiSecSiteConfig = "read_api_sec_site_config"
 const UpdateApiSecSiteConfig = "update_api_sec_site_config"
 
+const ReadApiSecDiscoveredApis = "read_api_sec_discovered_apis"
+
 const CreateCacheRule = "create_cache_rule"
 const ReadCacheRule = "read_cache_rule"
 const UpdateCacheRule = "update_cache_rule"
@@ -59,6 +83,8 @@ const DeleteIncapRule = "delete_incap_rule"
 
 const UpdateSecurityRule = "update_security_rule"
 
+const UpdateSiteAcl = "update_site_acl"
+
 const CreateSecurityRuleException = "create_security_rule_exception"
 const ReadSecurityRuleException = "read_security_rule_exception"
 const UpdateSecurityRuleException = "update_security_rule_exception"
@@ -81,6 +107,10 @@ const ReadDataStorageRegion = "read_data_storage_region"
 const UpdateDataStorageRegion = "update_data_storage_region"
 
 const UpdateOriginPop = "update_origin_pop"
+const ReadPops = "read_pops"
+
+const ReadSiteMonitoring = "read_site_monitoring"
+const UpdateSiteMonitoring = "update_site_monitoring"
 
 const CreateDataCenterServer = "create_data_center_server"
 const UpdateDataCenterServer = "update_data_center_server"
@@ -103,3 +133,5 @@ const CreateNotificationCenterPolicy = "create_notification_center_policy"
 const ReadNotificationCenterPolicy = "read_notification_center_policy"
 const UpdateNotificationCenterPolicy = "update_notification_center_policy"
 const DeleteNotificationCenterPolicy = "delete_notification_center_policy"
+
+const ReadNotificationCenterSubCategories = "read_notification_center_sub_categories"
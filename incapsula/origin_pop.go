@@ -0,0 +1,85 @@
+package incapsula
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// Geo regions of the data centers geo_locations, also used as the region of the PoPs
+var dataCenterGeoLocations = []string{"EUROPE", "AUSTRALIA", "US_EAST", "US_WEST", "AFRICA", "ASIA", "SOUTH_AMERICA", "NORTH_AMERICA"}
+
+// Approximate center of each geo region, as latitude and longitude
+var geoLocationCenters = map[string][2]float64{
+	"EUROPE":        {50, 10},
+	"AUSTRALIA":     {-25, 134},
+	"US_EAST":       {38, -78},
+	"US_WEST":       {37, -120},
+	"AFRICA":        {2, 20},
+	"ASIA":          {30, 100},
+	"SOUTH_AMERICA": {-15, -60},
+	"NORTH_AMERICA": {45, -100},
+}
+
+// validateOriginPop checks that the code is a PoP which can be used as an origin PoP
+func validateOriginPop(pops []Pop, code string) error {
+	originPops := []string{}
+	for _, pop := range pops {
+		if !pop.SupportsOriginPop {
+			continue
+		}
+		if pop.Code == code {
+			return nil
+		}
+		originPops = append(originPops, pop.Code)
+	}
+	sort.Strings(originPops)
+
+	return fmt.Errorf("%s is not a PoP supporting origin PoP, supported values are: %s", code, strings.Join(originPops, ", "))
+}
+
+// getNearestOriginPop returns the origin PoP nearest to the center of the region, preferring the PoPs of the region
+func getNearestOriginPop(pops []Pop, region string) (*Pop, error) {
+	center, ok := geoLocationCenters[region]
+	if !ok {
+		return nil, fmt.Errorf("unsupported region %s, supported values are: %s", region, strings.Join(dataCenterGeoLocations, ", "))
+	}
+
+	var nearest *Pop
+	nearestInRegion := false
+	nearestDistance := 0.0
+	for i := range pops {
+		pop := &pops[i]
+		if !pop.SupportsOriginPop {
+			continue
+		}
+
+		inRegion := pop.Region == region
+		distance := getGreatCircleDistance(center[0], center[1], pop.Latitude, pop.Longitude)
+		switch {
+		case nearest == nil,
+			inRegion && !nearestInRegion,
+			inRegion == nearestInRegion && (distance < nearestDistance || (distance == nearestDistance && pop.Code < nearest.Code)):
+			nearest, nearestInRegion, nearestDistance = pop, inRegion, distance
+		}
+	}
+
+	if nearest == nil {
+		return nil, fmt.Errorf("no PoP supports origin PoP")
+	}
+
+	return nearest, nil
+}
+
+// getGreatCircleDistance returns the distance in kilometers between two points given as latitude and longitude
+func getGreatCircleDistance(lat1, lon1, lat2, lon2 float64) float64 {
+	const earthRadius = 6371.0
+	toRadians := func(degrees float64) float64 { return degrees * math.Pi / 180 }
+
+	dLat := toRadians(lat2 - lat1)
+	dLon := toRadians(lon2 - lon1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(toRadians(lat1))*math.Cos(toRadians(lat2))*math.Sin(dLon/2)*math.Sin(dLon/2)
+
+	return earthRadius * 2 * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
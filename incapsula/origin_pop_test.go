@@ -0,0 +1,61 @@
+package incapsula
+
+import (
+	"testing"
+)
+
+var testPops = []Pop{
+	{Code: "lax", Region: "US_WEST", Latitude: 34.05, Longitude: -118.24, SupportsOriginPop: true},
+	{Code: "sjc", Region: "US_WEST", Latitude: 37.34, Longitude: -121.89, SupportsOriginPop: true},
+	{Code: "iad", Region: "US_EAST", Latitude: 38.95, Longitude: -77.45, SupportsOriginPop: true},
+	{Code: "ams", Region: "EUROPE", Latitude: 52.37, Longitude: 4.9, SupportsOriginPop: true},
+	{Code: "fra", Region: "EUROPE", Latitude: 50.11, Longitude: 8.68, SupportsOriginPop: false},
+	{Code: "jnb", Region: "AFRICA", Latitude: -26.2, Longitude: 28.05, SupportsOriginPop: false},
+}
+
+func TestValidateOriginPop(t *testing.T) {
+	if err := validateOriginPop(testPops, "lax"); err != nil {
+		t.Errorf("Should not have received an error, got: %s", err)
+	}
+
+	err := validateOriginPop(testPops, "fra")
+	if err == nil || err.Error() != "fra is not a PoP supporting origin PoP, supported values are: ams, iad, lax, sjc" {
+		t.Errorf("Should have received an unsupported origin PoP error, got: %v", err)
+	}
+
+	if err := validateOriginPop(testPops, "xyz"); err == nil {
+		t.Errorf("Should have received an error for an unknown PoP")
+	}
+}
+
+func TestGetNearestOriginPop(t *testing.T) {
+	cases := []struct {
+		region   string
+		expected string
+	}{
+		{"US_WEST", "sjc"},
+		{"US_EAST", "iad"},
+		// fra is nearer, but doesn't support origin PoP
+		{"EUROPE", "ams"},
+		// No origin PoP in the region
+		{"AFRICA", "ams"},
+	}
+
+	for _, c := range cases {
+		pop, err := getNearestOriginPop(testPops, c.region)
+		if err != nil {
+			t.Errorf("%s: should not have received an error, got: %s", c.region, err)
+			continue
+		}
+		if pop.Code != c.expected {
+			t.Errorf("%s: expected %s, got: %s", c.region, c.expected, pop.Code)
+		}
+	}
+
+	if _, err := getNearestOriginPop(testPops, "MARS"); err == nil {
+		t.Errorf("Should have received an error for an unsupported region")
+	}
+	if _, err := getNearestOriginPop(nil, "EUROPE"); err == nil {
+		t.Errorf("Should have received an error without origin PoPs")
+	}
+}
@@ -80,8 +80,15 @@ func Provider() *schema.Provider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"incapsula_role_abilities": dataSourceRoleAbilities(),
-			"incapsula_data_center":    dataSourceDataCenter(),
+			"incapsula_role_abilities":                     dataSourceRoleAbilities(),
+			"incapsula_account_api_keys":                   dataSourceAccountApiKeys(),
+			"incapsula_data_center":                        dataSourceDataCenter(),
+			"incapsula_pops":                               dataSourcePops(),
+			"incapsula_api_security_endpoints":             dataSourceApiSecurityEndpoints(),
+			"incapsula_api_security_discovered_apis":       dataSourceApiSecurityDiscoveredApis(),
+			"incapsula_csp_site_domains":                   dataSourceCSPSiteDomains(),
+			"incapsula_notification_center_sub_categories": dataSourceNotificationCenterSubCategories(),
+			"incapsula_notification_center_asset_types":    dataSourceNotificationCenterAssetTypes(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
@@ -95,17 +102,25 @@ func Provider() *schema.Provider {
 			"incapsula_policy_asset_association":     resourcePolicyAssetAssociation(),
 			"incapsula_security_rule_exception":      resourceSecurityRuleException(),
 			"incapsula_site":                         resourceSite(),
+			"incapsula_site_acl":                     resourceSiteACL(),
 			"incapsula_waf_security_rule":            resourceWAFSecurityRule(),
+			"incapsula_waf_rules_policy":             resourceWAFRulesPolicy(),
 			"incapsula_account":                      resourceAccount(),
 			"incapsula_subaccount":                   resourceSubAccount(),
+			"incapsula_account_role":                 resourceAccountRole(),
+			"incapsula_account_ssl_settings":         resourceAccountSSLSettings(),
+			"incapsula_account_api_key":              resourceAccountApiKey(),
+			"incapsula_account_user":                 resourceAccountUser(),
 			"incapsula_txt_record":                   resourceTXTRecord(),
 			"incapsula_data_centers_configuration":   resourceDataCentersConfiguration(),
+			"incapsula_site_monitoring":              resourceSiteMonitoring(),
 			"incapsula_api_security_site_config":     resourceApiSecuritySiteConfig(),
 			"incapsula_api_security_api_config":      resourceApiSecurityApiConfig(),
 			"incapsula_api_security_endpoint_config": resourceApiSecurityEndpointConfig(),
 			"incapsula_notification_center_policy":   resourceNotificationCenterPolicy(),
 			"incapsula_csp_site_configuration":       resourceCSPSiteConfiguration(),
 			"incapsula_csp_site_domain":              resourceCSPSiteDomain(),
+			"incapsula_csp_site_domain_list":         resourceCSPSiteDomainList(),
 		},
 	}
 
@@ -78,19 +78,23 @@ func resourceAccount() *schema.Resource {
 				Description: "Allow sites in the account to support all TLS versions for connectivity between clients (visitors) and the Imperva service.",
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
+				Deprecated:  "Use allow_support_old_tls_versions of incapsula_account_ssl_settings instead",
 			},
 			"naked_domain_san_for_new_www_sites": {
-				Description:  "Add naked domain SAN to Incapsula SSL certificates for new www sites. Options are `true` and `false`. Defaults to `true`",
+				Description:  "Add naked domain SAN to Incapsula SSL certificates for new www sites. Options are `true` and `false`.",
 				Type:         schema.TypeString,
-				Default:      "true",
 				Optional:     true,
+				Computed:     true,
+				Deprecated:   "Use add_naked_domain_san_for_www_sites of incapsula_account_ssl_settings instead",
 				ValidateFunc: validation.StringInSlice([]string{"true", "false", "default"}, false),
 			},
 			"wildcard_san_for_new_sites": {
-				Description:  "Add wildcard SAN to Incapsula SSL certificates for new sites. Options are `true`, `false` and `default`. Defaults to `default`",
+				Description:  "Add wildcard SAN to Incapsula SSL certificates for new sites. Options are `true`, `false` and `default`.",
 				Type:         schema.TypeString,
-				Default:      "Default",
 				Optional:     true,
+				Computed:     true,
+				Deprecated:   "Use use_wild_card_san_instead_of_fqdn of incapsula_account_ssl_settings instead",
 				ValidateFunc: validation.StringInSlice([]string{"True", "False", "Default"}, true),
 			},
 			"data_storage_region": {
@@ -0,0 +1,203 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAccountApiKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAccountApiKeyCreate,
+		Read:   resourceAccountApiKeyRead,
+		Update: resourceAccountApiKeyUpdate,
+		Delete: resourceAccountApiKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idSlice := strings.Split(d.Id(), "/")
+				if len(idSlice) != 2 || idSlice[0] == "" || idSlice[1] == "" {
+					return nil, fmt.Errorf("unexpected format of ID (%q), expected account_id/api_id", d.Id())
+				}
+
+				accountID, err := strconv.Atoi(idSlice[0])
+				if err != nil {
+					return nil, fmt.Errorf("failed to convert account ID from import command, actual value: %s, expected numeric ID", idSlice[0])
+				}
+
+				d.Set("account_id", accountID)
+				d.SetId(idSlice[1])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Optional Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account or sub-account of the API key. If not specified, the account of the API credentials.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description: "The name of the API key.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"description": {
+				Description: "The description of the API key.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"status": {
+				Description:  "The status of the API key: ENABLED or DISABLED.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "ENABLED",
+				ValidateFunc: validation.StringInSlice([]string{"ENABLED", "DISABLED"}, false),
+			},
+			"expiration_period_in_days": {
+				Description:  "The number of days the API key is valid for. If not specified, the API key doesn't expire.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			// Computed Attributes
+			"api_id": {
+				Description: "The API ID, used with the API key to authenticate to the API.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+			"api_key": {
+				Description: "The API key. Only known when the API key is created, empty after an import.",
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+			},
+			"expiration_date": {
+				Description: "The expiration date of the API key.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceAccountApiKeyCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+	accountID := d.Get("account_id").(int)
+
+	responseDTO, err := client.AddAccountApiKey(accountID, AccountApiKey{
+		Name:                   d.Get("name").(string),
+		Description:            d.Get("description").(string),
+		Status:                 d.Get("status").(string),
+		ExpirationPeriodInDays: d.Get("expiration_period_in_days").(int),
+	})
+	if err != nil {
+		return fmt.Errorf("Error creating API key for account (%d): %s", accountID, err)
+	}
+	if len(responseDTO.Errors) > 0 || len(responseDTO.Data) == 0 {
+		return fmt.Errorf("Error creating API key for account (%d): %s", accountID, formatApiErrors(responseDTO.Errors))
+	}
+
+	apiKey := responseDTO.Data[0]
+	d.SetId(strconv.Itoa(apiKey.ID))
+	d.Set("account_id", apiKey.AccountID)
+
+	// The key is only returned on creation
+	d.Set("api_key", apiKey.ApiKey)
+
+	log.Printf("[INFO] Created Incapsula API key %d for account (%d)\n", apiKey.ID, apiKey.AccountID)
+
+	return resourceAccountApiKeyRead(d, m)
+}
+
+func resourceAccountApiKeyRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+	accountID := d.Get("account_id").(int)
+
+	apiKeyID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("failed to convert API key ID, actual value: %s, expected numeric ID", d.Id())
+	}
+
+	responseDTO, err := client.GetAccountApiKey(accountID, apiKeyID)
+	if err != nil {
+		return fmt.Errorf("Error getting API key (%d) of account (%d): %s", apiKeyID, accountID, err)
+	}
+
+	if len(responseDTO.Errors) > 0 {
+		if responseDTO.Errors[0].Status == "404" {
+			log.Printf("[INFO] Incapsula API key %d of account (%d) has already been revoked\n", apiKeyID, accountID)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error getting API key (%d) of account (%d): %s", apiKeyID, accountID, formatApiErrors(responseDTO.Errors))
+	}
+	if len(responseDTO.Data) == 0 {
+		log.Printf("[INFO] Incapsula API key %d of account (%d) has already been revoked\n", apiKeyID, accountID)
+		d.SetId("")
+		return nil
+	}
+
+	apiKey := responseDTO.Data[0]
+	if apiKey.AccountID != 0 {
+		d.Set("account_id", apiKey.AccountID)
+	}
+	d.Set("api_id", d.Id())
+	d.Set("name", apiKey.Name)
+	d.Set("description", apiKey.Description)
+	d.Set("status", apiKey.Status)
+	d.Set("expiration_date", apiKey.ExpirationDate)
+	if apiKey.ExpirationPeriodInDays != 0 {
+		d.Set("expiration_period_in_days", apiKey.ExpirationPeriodInDays)
+	}
+
+	return nil
+}
+
+func resourceAccountApiKeyUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+	accountID := d.Get("account_id").(int)
+	apiKeyID, _ := strconv.Atoi(d.Id())
+
+	responseDTO, err := client.UpdateAccountApiKey(accountID, apiKeyID, AccountApiKey{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Status:      d.Get("status").(string),
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating API key (%d) of account (%d): %s", apiKeyID, accountID, err)
+	}
+	if len(responseDTO.Errors) > 0 {
+		return fmt.Errorf("Error updating API key (%d) of account (%d): %s", apiKeyID, accountID, formatApiErrors(responseDTO.Errors))
+	}
+
+	return resourceAccountApiKeyRead(d, m)
+}
+
+func resourceAccountApiKeyDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+	accountID := d.Get("account_id").(int)
+	apiKeyID, _ := strconv.Atoi(d.Id())
+
+	responseDTO, err := client.DeleteAccountApiKey(accountID, apiKeyID)
+	if err != nil {
+		return fmt.Errorf("Error revoking API key (%d) of account (%d): %s", apiKeyID, accountID, err)
+	}
+	if len(responseDTO.Errors) > 0 && responseDTO.Errors[0].Status != "404" {
+		return fmt.Errorf("Error revoking API key (%d) of account (%d): %s", apiKeyID, accountID, formatApiErrors(responseDTO.Errors))
+	}
+
+	// Set the ID to empty
+	// Implicitly clears the resource
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const accountApiKeyResource = "incapsula_account_api_key"
+const accountApiKeyName = "testacc-terraform-account-api-key"
+const accountApiKeyResourceName = accountApiKeyResource + "." + accountApiKeyName
+
+func TestAccIncapsulaAccountApiKey_Basic(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[DEBUG] Running test resource_account_api_key_test.TestAccIncapsulaAccountApiKey_Basic")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIncapsulaAccountApiKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaAccountApiKeyConfig("ENABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaAccountApiKeyExists(accountApiKeyResourceName),
+					resource.TestCheckResourceAttr(accountApiKeyResourceName, "name", accountApiKeyName),
+					resource.TestCheckResourceAttr(accountApiKeyResourceName, "status", "ENABLED"),
+					resource.TestCheckResourceAttrSet(accountApiKeyResourceName, "api_key"),
+					resource.TestCheckResourceAttrSet(accountApiKeyResourceName, "expiration_date"),
+				),
+			},
+			{
+				Config: testAccCheckIncapsulaAccountApiKeyConfig("DISABLED"),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaAccountApiKeyExists(accountApiKeyResourceName),
+					resource.TestCheckResourceAttr(accountApiKeyResourceName, "status", "DISABLED"),
+					resource.TestCheckResourceAttrSet(accountApiKeyResourceName, "api_key"),
+				),
+			},
+			{
+				ResourceName:            accountApiKeyResourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccStateAccountApiKeyID,
+				ImportStateVerifyIgnore: []string{"api_key", "expiration_period_in_days"},
+			},
+		},
+	})
+}
+
+func testAccStateAccountApiKeyID(state *terraform.State) (string, error) {
+	for _, res := range state.RootModule().Resources {
+		if res.Type != accountApiKeyResource {
+			continue
+		}
+
+		return fmt.Sprintf("%s/%s", res.Primary.Attributes["account_id"], res.Primary.ID), nil
+	}
+
+	return "", fmt.Errorf("Error finding an Incapsula account API key")
+}
+
+func testCheckIncapsulaAccountApiKeyExists(name string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		res, ok := state.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Incapsula account API key resource not found: %s", name)
+		}
+
+		apiKeyID, err := strconv.Atoi(res.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error parsing ID %v to int", res.Primary.ID)
+		}
+		accountID, _ := strconv.Atoi(res.Primary.Attributes["account_id"])
+
+		client := testAccProvider.Meta().(*Client)
+		responseDTO, err := client.GetAccountApiKey(accountID, apiKeyID)
+		if err != nil || len(responseDTO.Errors) > 0 || len(responseDTO.Data) == 0 {
+			return fmt.Errorf("Incapsula account API key %d does not exist: %v", apiKeyID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIncapsulaAccountApiKeyDestroy(state *terraform.State) error {
+	client := testAccProvider.Meta().(*Client)
+
+	for _, res := range state.RootModule().Resources {
+		if res.Type != accountApiKeyResource {
+			continue
+		}
+
+		apiKeyID, _ := strconv.Atoi(res.Primary.ID)
+		accountID, _ := strconv.Atoi(res.Primary.Attributes["account_id"])
+		responseDTO, err := client.GetAccountApiKey(accountID, apiKeyID)
+		if err != nil {
+			return err
+		}
+		if len(responseDTO.Errors) == 0 && len(responseDTO.Data) > 0 {
+			return fmt.Errorf("Incapsula account API key %d still exists", apiKeyID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIncapsulaAccountApiKeyConfig(status string) string {
+	return fmt.Sprintf(`
+resource "%s" "%s" {
+  name                      = "%s"
+  description               = "Created by the acceptance tests"
+  status                    = "%s"
+  expiration_period_in_days = 30
+}`, accountApiKeyResource, accountApiKeyName, accountApiKeyName, status,
+	)
+}
@@ -0,0 +1,198 @@
+package incapsula
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAccountRole() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAccountRoleCreate,
+		Read:   resourceAccountRoleRead,
+		Update: resourceAccountRoleUpdate,
+		Delete: resourceAccountRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			return validateAccountRoleAbilitiesDiff(d, m)
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"name": {
+				Description: "The name of the role.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			// Optional Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account or sub-account of the role. If not specified, the account of the API credentials.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Description: "The description of the role.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"abilities": {
+				Description: "The keys of the abilities granted by the role, e.g. canAddSite. Validated at plan time against the abilities of the account.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// validateAccountRoleAbilitiesDiff fails the plan when an ability is not in the abilities catalogue of the account.
+// The validation is skipped when the abilities can't be fetched.
+func validateAccountRoleAbilitiesDiff(d *schema.ResourceDiff, m interface{}) error {
+	if !d.HasChange("abilities") || !d.NewValueKnown("abilities") || !d.NewValueKnown("account_id") {
+		return nil
+	}
+	if rawConfig := d.GetRawConfig(); !rawConfig.IsNull() && !rawConfig.GetAttr("abilities").IsWhollyKnown() {
+		return nil
+	}
+
+	abilities := []string{}
+	for _, ability := range d.Get("abilities").(*schema.Set).List() {
+		abilities = append(abilities, ability.(string))
+	}
+	if len(abilities) == 0 {
+		return nil
+	}
+
+	catalogue, err := m.(*Client).GetAccountAbilities(d.Get("account_id").(int))
+	if err != nil {
+		log.Printf("[WARN] Could not validate the abilities of the role: %s\n", err)
+		return nil
+	}
+
+	if unknown := getUnknownAbilities(abilities, catalogue); len(unknown) > 0 {
+		return fmt.Errorf("abilities: unknown abilities %s, the abilities of the account are listed by the incapsula_role_abilities data source", strings.Join(unknown, ", "))
+	}
+
+	return nil
+}
+
+// getUnknownAbilities returns the sorted abilities which are not in the catalogue
+func getUnknownAbilities(abilities []string, catalogue []Ability) []string {
+	keys := map[string]bool{}
+	for _, ability := range catalogue {
+		keys[ability.AbilityKey] = true
+	}
+
+	unknown := []string{}
+	for _, ability := range abilities {
+		if !keys[ability] {
+			unknown = append(unknown, ability)
+		}
+	}
+	sort.Strings(unknown)
+
+	return unknown
+}
+
+func populateFromConfAccountRole(d *schema.ResourceData) *AccountRoleRequest {
+	abilities := []string{}
+	for _, ability := range d.Get("abilities").(*schema.Set).List() {
+		abilities = append(abilities, ability.(string))
+	}
+	sort.Strings(abilities)
+
+	return &AccountRoleRequest{
+		RoleName:        d.Get("name").(string),
+		RoleDescription: d.Get("description").(string),
+		AccountID:       d.Get("account_id").(int),
+		RoleAbilities:   abilities,
+	}
+}
+
+func resourceAccountRoleCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	role, err := client.AddAccountRole(populateFromConfAccountRole(d))
+	if err != nil {
+		log.Printf("[ERROR] Could not create Incapsula role %s: %s\n", d.Get("name"), err)
+		return err
+	}
+
+	d.SetId(strconv.Itoa(role.RoleID))
+	log.Printf("[INFO] Created Incapsula role %s with ID: %d\n", d.Get("name"), role.RoleID)
+
+	return resourceAccountRoleRead(d, m)
+}
+
+func resourceAccountRoleRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	roleID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("failed to convert role ID, actual value: %s, expected numeric ID", d.Id())
+	}
+
+	role, err := client.GetAccountRole(roleID)
+	if err != nil {
+		log.Printf("[ERROR] Could not read Incapsula role %d: %s\n", roleID, err)
+		return err
+	}
+
+	if role == nil {
+		log.Printf("[INFO] Incapsula role %d has already been deleted\n", roleID)
+		d.SetId("")
+		return nil
+	}
+
+	abilities := make([]string, 0, len(role.RoleAbilities))
+	for _, ability := range role.RoleAbilities {
+		abilities = append(abilities, ability.AbilityKey)
+	}
+
+	d.Set("account_id", role.AccountID)
+	d.Set("name", role.RoleName)
+	d.Set("description", role.RoleDescription)
+	d.Set("abilities", abilities)
+
+	return nil
+}
+
+func resourceAccountRoleUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	roleID, _ := strconv.Atoi(d.Id())
+	_, err := client.UpdateAccountRole(roleID, populateFromConfAccountRole(d))
+	if err != nil {
+		log.Printf("[ERROR] Could not update Incapsula role %d: %s\n", roleID, err)
+		return err
+	}
+
+	return resourceAccountRoleRead(d, m)
+}
+
+func resourceAccountRoleDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	roleID, _ := strconv.Atoi(d.Id())
+	err := client.DeleteAccountRole(roleID)
+	if err != nil {
+		log.Printf("[ERROR] Could not delete Incapsula role %d: %s\n", roleID, err)
+		return err
+	}
+
+	// Set the ID to empty
+	// Implicitly clears the resource
+	d.SetId("")
+
+	return nil
+}
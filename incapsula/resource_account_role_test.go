@@ -0,0 +1,110 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const accountRoleResource = "incapsula_account_role"
+const accountRoleName = "testacc-terraform-account-role"
+const accountRoleResourceName = accountRoleResource + "." + accountRoleName
+
+func TestAccIncapsulaAccountRole_Basic(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[DEBUG] Running test resource_account_role_test.TestAccIncapsulaAccountRole_Basic")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIncapsulaAccountRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaAccountRoleConfig("Reads the audit trail", `"canViewAuditTrail"`),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaAccountRoleExists(accountRoleResourceName),
+					resource.TestCheckResourceAttr(accountRoleResourceName, "name", accountRoleName),
+					resource.TestCheckResourceAttr(accountRoleResourceName, "abilities.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIncapsulaAccountRoleConfig("Reads the audit trail and the policies", `"canViewAuditTrail", "canViewPolicy"`),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaAccountRoleExists(accountRoleResourceName),
+					resource.TestCheckResourceAttr(accountRoleResourceName, "description", "Reads the audit trail and the policies"),
+					resource.TestCheckResourceAttr(accountRoleResourceName, "abilities.#", "2"),
+				),
+			},
+			{
+				ResourceName:      accountRoleResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestGetUnknownAbilities(t *testing.T) {
+	catalogue := []Ability{{AbilityKey: "canAddSite"}, {AbilityKey: "canViewAuditTrail"}}
+
+	unknown := getUnknownAbilities([]string{"canViewAuditTrail", "canFlyAway", "canAddSite", "canDance"}, catalogue)
+	if fmt.Sprint(unknown) != "[canDance canFlyAway]" {
+		t.Errorf("Expected [canDance canFlyAway], got: %v", unknown)
+	}
+}
+
+func testCheckIncapsulaAccountRoleExists(name string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		res, ok := state.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Incapsula account role resource not found: %s", name)
+		}
+
+		roleID, err := strconv.Atoi(res.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error parsing ID %v to int", res.Primary.ID)
+		}
+
+		client := testAccProvider.Meta().(*Client)
+		role, err := client.GetAccountRole(roleID)
+		if err != nil || role == nil {
+			return fmt.Errorf("Incapsula account role %d does not exist: %v", roleID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIncapsulaAccountRoleDestroy(state *terraform.State) error {
+	client := testAccProvider.Meta().(*Client)
+
+	for _, res := range state.RootModule().Resources {
+		if res.Type != accountRoleResource {
+			continue
+		}
+
+		roleID, _ := strconv.Atoi(res.Primary.ID)
+		role, err := client.GetAccountRole(roleID)
+		if err != nil {
+			return err
+		}
+		if role != nil {
+			return fmt.Errorf("Incapsula account role %d still exists", roleID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIncapsulaAccountRoleConfig(description, abilities string) string {
+	return fmt.Sprintf(`
+resource "%s" "%s" {
+  name        = "%s"
+  description = "%s"
+  abilities   = [%s]
+}`, accountRoleResource, accountRoleName, accountRoleName, description, abilities,
+	)
+}
@@ -0,0 +1,298 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceAccountSSLSettings() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAccountSSLSettingsUpdate,
+		Read:   resourceAccountSSLSettingsRead,
+		Update: resourceAccountSSLSettingsUpdate,
+		Delete: resourceAccountSSLSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				accountID, err := strconv.Atoi(d.Id())
+				if err != nil {
+					return nil, fmt.Errorf("failed to convert account ID from import command, actual value: %s, expected numeric ID", d.Id())
+				}
+
+				d.Set("account_id", accountID)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		// The settings which are not configured keep their current value, e.g. inherited from the parent account
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account or sub-account.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			// Optional Arguments
+			"allow_support_old_tls_versions": {
+				Description: "Allow the sites of the account to support TLS 1.0 and 1.1 for connectivity between clients (visitors) and the Imperva service.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"add_naked_domain_san_for_www_sites": {
+				Description: "Add the naked domain SAN to the Imperva certificates of new www sites.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"use_wild_card_san_instead_of_fqdn": {
+				Description: "Add the wildcard SAN instead of the FQDN SAN to the Imperva certificates of new sites.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"enable_hsts_for_new_sites": {
+				Description: "Enable HSTS for new sites.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"hsts_max_age": {
+				Description:  "The HSTS max age of new sites, in seconds.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"hsts_include_sub_domains": {
+				Description: "Include the sub-domains in the HSTS policy of new sites.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"hsts_preload": {
+				Description: "Add new sites to the HSTS preload list.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"default_validation_method": {
+				Description:  "The default domain validation method of the Imperva certificates of new sites: CNAME, DNS, EMAIL or HTML_FILE.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"CNAME", "DNS", "EMAIL", "HTML_FILE"}, false),
+			},
+			"allow_cname_validation": {
+				Description: "Delegate the domain validation of the Imperva certificates to Imperva by CNAME.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"allowed_domains_for_cname_validation": {
+				Description: "The domains which can be validated by CNAME.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"allowed_certificate_authorities": {
+				Description: "The certificate authorities which can issue the Imperva certificates of the account, e.g. GLOBALSIGN.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			// Computed Attributes
+			"value_for_cname_validation": {
+				Description: "The CNAME record value for the domain validation by CNAME.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// isAccountSSLSettingConfigured returns whether a setting is in the configuration, the other settings keep their current value
+func isAccountSSLSettingConfigured(d *schema.ResourceData, key string) bool {
+	rawConfig := d.GetRawConfig()
+	return !rawConfig.IsNull() && !rawConfig.GetAttr(key).IsNull()
+}
+
+func expandAccountSSLSettingsSet(set *schema.Set) []string {
+	values := make([]string, 0, set.Len())
+	for _, value := range set.List() {
+		values = append(values, value.(string))
+	}
+	sort.Strings(values)
+
+	return values
+}
+
+// populateFromConfAccountSSLSettings overrides the current settings with the configured settings
+func populateFromConfAccountSSLSettings(d *schema.ResourceData, settings AccountSSLSettings) AccountSSLSettings {
+	if settings.HSTS == nil {
+		settings.HSTS = &AccountSSLSettingsHSTS{}
+	}
+	if settings.ImpervaCertificate == nil {
+		settings.ImpervaCertificate = &AccountSSLSettingsImpervaCertificate{}
+	}
+	if settings.ImpervaCertificate.Delegation == nil {
+		settings.ImpervaCertificate.Delegation = &AccountSSLSettingsDelegation{}
+	}
+	certificate := settings.ImpervaCertificate
+	delegation := certificate.Delegation
+
+	if isAccountSSLSettingConfigured(d, "allow_support_old_tls_versions") {
+		settings.AllowSupportOldTLSVersions = d.Get("allow_support_old_tls_versions").(bool)
+	}
+	if isAccountSSLSettingConfigured(d, "enable_hsts_for_new_sites") {
+		settings.EnableHSTSForNewSites = d.Get("enable_hsts_for_new_sites").(bool)
+	}
+	if isAccountSSLSettingConfigured(d, "hsts_max_age") {
+		settings.HSTS.MaxAge = d.Get("hsts_max_age").(int)
+	}
+	if isAccountSSLSettingConfigured(d, "hsts_include_sub_domains") {
+		settings.HSTS.SubDomainsIncluded = d.Get("hsts_include_sub_domains").(bool)
+	}
+	if isAccountSSLSettingConfigured(d, "hsts_preload") {
+		settings.HSTS.PreLoaded = d.Get("hsts_preload").(bool)
+	}
+	if isAccountSSLSettingConfigured(d, "add_naked_domain_san_for_www_sites") {
+		certificate.AddNakedDomainSanForWWWSites = d.Get("add_naked_domain_san_for_www_sites").(bool)
+	}
+	if isAccountSSLSettingConfigured(d, "use_wild_card_san_instead_of_fqdn") {
+		certificate.UseWildCardSanInsteadOfFQDN = d.Get("use_wild_card_san_instead_of_fqdn").(bool)
+	}
+	if isAccountSSLSettingConfigured(d, "default_validation_method") {
+		certificate.DefaultValidationMethod = d.Get("default_validation_method").(string)
+	}
+	if isAccountSSLSettingConfigured(d, "allowed_certificate_authorities") {
+		certificate.AllowedCertificateAuthorities = expandAccountSSLSettingsSet(d.Get("allowed_certificate_authorities").(*schema.Set))
+	}
+	if isAccountSSLSettingConfigured(d, "allow_cname_validation") {
+		delegation.AllowCNAMEValidation = d.Get("allow_cname_validation").(bool)
+	}
+	if isAccountSSLSettingConfigured(d, "allowed_domains_for_cname_validation") {
+		delegation.AllowedDomainsForCNAMEValidation = expandAccountSSLSettingsSet(d.Get("allowed_domains_for_cname_validation").(*schema.Set))
+	}
+
+	// The CNAME value is generated by Imperva
+	delegation.ValueForCNAMEValidation = ""
+
+	return settings
+}
+
+func resourceAccountSSLSettingsUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+	accountID := d.Get("account_id").(int)
+
+	currentDTO, err := client.GetAccountSSLSettings(accountID)
+	if err != nil {
+		return fmt.Errorf("Error getting the SSL settings of account (%d): %s", accountID, err)
+	}
+	if len(currentDTO.Errors) > 0 {
+		return fmt.Errorf("Error getting the SSL settings of account (%d): %s", accountID, formatApiErrors(currentDTO.Errors))
+	}
+
+	current := AccountSSLSettings{}
+	if len(currentDTO.Data) > 0 {
+		current = currentDTO.Data[0]
+	}
+
+	responseDTO, err := client.UpdateAccountSSLSettings(accountID, &AccountSSLSettingsDTO{
+		Data: []AccountSSLSettings{populateFromConfAccountSSLSettings(d, current)},
+	})
+	if err != nil {
+		return fmt.Errorf("Error updating the SSL settings of account (%d): %s", accountID, err)
+	}
+	if len(responseDTO.Errors) > 0 {
+		return fmt.Errorf("Error updating the SSL settings of account (%d): %s", accountID, formatApiErrors(responseDTO.Errors))
+	}
+
+	d.SetId(strconv.Itoa(accountID))
+
+	return resourceAccountSSLSettingsRead(d, m)
+}
+
+func resourceAccountSSLSettingsRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	accountID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("failed to convert account ID, actual value: %s, expected numeric ID", d.Id())
+	}
+
+	responseDTO, err := client.GetAccountSSLSettings(accountID)
+	if err != nil {
+		return fmt.Errorf("Error getting the SSL settings of account (%d): %s", accountID, err)
+	}
+
+	if len(responseDTO.Errors) > 0 {
+		if responseDTO.Errors[0].Status == "404" {
+			log.Printf("[INFO] Incapsula account (%d) has already been deleted\n", accountID)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error getting the SSL settings of account (%d): %s", accountID, formatApiErrors(responseDTO.Errors))
+	}
+
+	settings := AccountSSLSettings{}
+	if len(responseDTO.Data) > 0 {
+		settings = responseDTO.Data[0]
+	}
+	hsts := AccountSSLSettingsHSTS{}
+	if settings.HSTS != nil {
+		hsts = *settings.HSTS
+	}
+	certificate := AccountSSLSettingsImpervaCertificate{}
+	if settings.ImpervaCertificate != nil {
+		certificate = *settings.ImpervaCertificate
+	}
+	delegation := AccountSSLSettingsDelegation{}
+	if certificate.Delegation != nil {
+		delegation = *certificate.Delegation
+	}
+
+	d.Set("account_id", accountID)
+	d.Set("allow_support_old_tls_versions", settings.AllowSupportOldTLSVersions)
+	d.Set("enable_hsts_for_new_sites", settings.EnableHSTSForNewSites)
+	d.Set("hsts_max_age", hsts.MaxAge)
+	d.Set("hsts_include_sub_domains", hsts.SubDomainsIncluded)
+	d.Set("hsts_preload", hsts.PreLoaded)
+	d.Set("add_naked_domain_san_for_www_sites", certificate.AddNakedDomainSanForWWWSites)
+	d.Set("use_wild_card_san_instead_of_fqdn", certificate.UseWildCardSanInsteadOfFQDN)
+	d.Set("default_validation_method", certificate.DefaultValidationMethod)
+	d.Set("allowed_certificate_authorities", certificate.AllowedCertificateAuthorities)
+	d.Set("allow_cname_validation", delegation.AllowCNAMEValidation)
+	d.Set("allowed_domains_for_cname_validation", delegation.AllowedDomainsForCNAMEValidation)
+	d.Set("value_for_cname_validation", delegation.ValueForCNAMEValidation)
+
+	return nil
+}
+
+func resourceAccountSSLSettingsDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+	accountID, _ := strconv.Atoi(d.Id())
+
+	responseDTO, err := client.DeleteAccountSSLSettings(accountID)
+	if err != nil {
+		return fmt.Errorf("Error resetting the SSL settings of account (%d): %s", accountID, err)
+	}
+	if len(responseDTO.Errors) > 0 && responseDTO.Errors[0].Status != "404" {
+		return fmt.Errorf("Error resetting the SSL settings of account (%d): %s", accountID, formatApiErrors(responseDTO.Errors))
+	}
+
+	// Set the ID to empty
+	// Implicitly clears the resource
+	d.SetId("")
+
+	return nil
+}
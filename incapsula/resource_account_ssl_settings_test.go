@@ -0,0 +1,85 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const accountSSLSettingsResource = "incapsula_account_ssl_settings"
+const accountSSLSettingsName = "testacc-terraform-account-ssl-settings"
+const accountSSLSettingsResourceName = accountSSLSettingsResource + "." + accountSSLSettingsName
+
+func TestAccIncapsulaAccountSSLSettings_Basic(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[DEBUG] Running test resource_account_ssl_settings_test.TestAccIncapsulaAccountSSLSettings_Basic")
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaAccountSSLSettingsConfig(true, 31536000),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaAccountSSLSettingsExists(accountSSLSettingsResourceName),
+					resource.TestCheckResourceAttr(accountSSLSettingsResourceName, "enable_hsts_for_new_sites", "true"),
+					resource.TestCheckResourceAttr(accountSSLSettingsResourceName, "hsts_max_age", "31536000"),
+					resource.TestCheckResourceAttr(accountSSLSettingsResourceName, "add_naked_domain_san_for_www_sites", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIncapsulaAccountSSLSettingsConfig(false, 86400),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaAccountSSLSettingsExists(accountSSLSettingsResourceName),
+					resource.TestCheckResourceAttr(accountSSLSettingsResourceName, "enable_hsts_for_new_sites", "false"),
+					resource.TestCheckResourceAttr(accountSSLSettingsResourceName, "hsts_max_age", "86400"),
+				),
+			},
+			{
+				ResourceName:      accountSSLSettingsResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckIncapsulaAccountSSLSettingsExists(name string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		res, ok := state.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Incapsula account SSL settings resource not found: %s", name)
+		}
+
+		accountID, err := strconv.Atoi(res.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Error parsing ID %v to int", res.Primary.ID)
+		}
+
+		client := testAccProvider.Meta().(*Client)
+		responseDTO, err := client.GetAccountSSLSettings(accountID)
+		if err != nil || len(responseDTO.Errors) > 0 || len(responseDTO.Data) == 0 {
+			return fmt.Errorf("Incapsula SSL settings of account %d do not exist: %v", accountID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIncapsulaAccountSSLSettingsConfig(enableHSTS bool, maxAge int) string {
+	return fmt.Sprintf(`
+resource "incapsula_subaccount" "%s" {
+  sub_account_name = "%s"
+}
+
+resource "%s" "%s" {
+  account_id                         = incapsula_subaccount.%s.id
+  add_naked_domain_san_for_www_sites = true
+  enable_hsts_for_new_sites          = %t
+  hsts_max_age                       = %d
+}`, accountSSLSettingsName, accountSSLSettingsName, accountSSLSettingsResource, accountSSLSettingsName, accountSSLSettingsName, enableHSTS, maxAge,
+	)
+}
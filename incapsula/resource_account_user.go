@@ -0,0 +1,267 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceAccountUser() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAccountUserCreate,
+		Read:   resourceAccountUserRead,
+		Update: resourceAccountUserUpdate,
+		Delete: resourceAccountUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idSlice := strings.SplitN(d.Id(), "/", 2)
+				if len(idSlice) != 2 || idSlice[0] == "" || idSlice[1] == "" {
+					return nil, fmt.Errorf("unexpected format of ID (%q), expected account_id/email", d.Id())
+				}
+
+				accountID, err := strconv.Atoi(idSlice[0])
+				if err != nil {
+					return nil, fmt.Errorf("failed to convert account ID from import command, actual value: %s, expected numeric ID", idSlice[0])
+				}
+
+				d.Set("account_id", accountID)
+				d.Set("email", idSlice[1])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"email": {
+				Description: "The email of the user, used to log in to the Imperva console.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			// Optional Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account of the user. If not specified, the account of the API credentials.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"first_name": {
+				Description: "The first name of the user.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"last_name": {
+				Description: "The last name of the user.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"role_ids": {
+				Description: "The ids of the roles of the user in the account.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"sub_account_assignment": {
+				Description: "The sub-accounts the user has access to, and the roles of the user in each of them.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sub_account_id": {
+							Description: "Numeric identifier of the sub-account.",
+							Type:        schema.TypeInt,
+							Required:    true,
+						},
+						"role_ids": {
+							Description: "The ids of the roles of the user in the sub-account.",
+							Type:        schema.TypeSet,
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+						},
+					},
+				},
+			},
+
+			// Computed Attributes
+			"user_id": {
+				Description: "Numeric identifier of the user.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func expandAccountUserRoleIDs(roleIDs *schema.Set) []int {
+	ids := make([]int, 0, roleIDs.Len())
+	for _, roleID := range roleIDs.List() {
+		ids = append(ids, roleID.(int))
+	}
+	sort.Ints(ids)
+
+	return ids
+}
+
+// getAccountUserSubAccountAssignments returns the assignments setting the roles of the user in the new sub-accounts,
+// and removing the access to the old sub-accounts which are no longer assigned, sorted by account id
+func getAccountUserSubAccountAssignments(email string, oldAssignments, newAssignments []interface{}) []AccountUserAssignment {
+	roleIDs := map[int][]int{}
+	for _, assignment := range oldAssignments {
+		roleIDs[assignment.(map[string]interface{})["sub_account_id"].(int)] = []int{}
+	}
+	for _, assignment := range newAssignments {
+		assignmentMap := assignment.(map[string]interface{})
+		roleIDs[assignmentMap["sub_account_id"].(int)] = expandAccountUserRoleIDs(assignmentMap["role_ids"].(*schema.Set))
+	}
+
+	assignments := make([]AccountUserAssignment, 0, len(roleIDs))
+	for accountID, ids := range roleIDs {
+		assignments = append(assignments, AccountUserAssignment{AccountID: accountID, Email: email, RoleIds: ids})
+	}
+	sort.Slice(assignments, func(i, j int) bool { return assignments[i].AccountID < assignments[j].AccountID })
+
+	return assignments
+}
+
+func resourceAccountUserCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+	email := d.Get("email").(string)
+
+	user, err := client.AddAccountUser(&AccountUserRequest{
+		AccountID: d.Get("account_id").(int),
+		Email:     email,
+		FirstName: d.Get("first_name").(string),
+		LastName:  d.Get("last_name").(string),
+		RoleIds:   expandAccountUserRoleIDs(d.Get("role_ids").(*schema.Set)),
+	})
+	if err != nil {
+		log.Printf("[ERROR] Could not create Incapsula user %s: %s\n", email, err)
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%d/%s", user.AccountID, email))
+	d.Set("account_id", user.AccountID)
+	log.Printf("[INFO] Created Incapsula user %s in account %d\n", email, user.AccountID)
+
+	assignments := getAccountUserSubAccountAssignments(email, nil, d.Get("sub_account_assignment").(*schema.Set).List())
+	if len(assignments) > 0 {
+		err = client.AssignAccountUserRoles(assignments)
+		if err != nil {
+			log.Printf("[ERROR] Could not assign the sub-accounts of Incapsula user %s: %s\n", email, err)
+			return err
+		}
+	}
+
+	return resourceAccountUserRead(d, m)
+}
+
+func resourceAccountUserRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+	accountID := d.Get("account_id").(int)
+	email := d.Get("email").(string)
+
+	user, err := client.GetAccountUser(accountID, email)
+	if err != nil {
+		log.Printf("[ERROR] Could not read Incapsula user %s of account %d: %s\n", email, accountID, err)
+		return err
+	}
+
+	if user == nil {
+		log.Printf("[INFO] Incapsula user %s of account %d has already been deleted\n", email, accountID)
+		d.SetId("")
+		return nil
+	}
+
+	roleIDs := make([]int, 0, len(user.Roles))
+	for _, role := range user.Roles {
+		roleIDs = append(roleIDs, role.RoleID)
+	}
+
+	d.SetId(fmt.Sprintf("%d/%s", accountID, email))
+	d.Set("user_id", user.UserID)
+	d.Set("first_name", user.FirstName)
+	d.Set("last_name", user.LastName)
+	d.Set("role_ids", roleIDs)
+
+	// Only the sub-accounts of the state are read, an access removed outside of Terraform is shown as a change
+	subAccountAssignments := make([]interface{}, 0)
+	for _, assignment := range d.Get("sub_account_assignment").(*schema.Set).List() {
+		subAccountID := assignment.(map[string]interface{})["sub_account_id"].(int)
+		subAccountUser, err := client.GetAccountUser(subAccountID, email)
+		if err != nil {
+			log.Printf("[ERROR] Could not read Incapsula user %s of sub-account %d: %s\n", email, subAccountID, err)
+			return err
+		}
+		if subAccountUser == nil || len(subAccountUser.Roles) == 0 {
+			continue
+		}
+
+		subAccountRoleIDs := make([]interface{}, 0, len(subAccountUser.Roles))
+		for _, role := range subAccountUser.Roles {
+			subAccountRoleIDs = append(subAccountRoleIDs, role.RoleID)
+		}
+		subAccountAssignments = append(subAccountAssignments, map[string]interface{}{
+			"sub_account_id": subAccountID,
+			"role_ids":       schema.NewSet(schema.HashInt, subAccountRoleIDs),
+		})
+	}
+	d.Set("sub_account_assignment", subAccountAssignments)
+
+	return nil
+}
+
+func resourceAccountUserUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+	accountID := d.Get("account_id").(int)
+	email := d.Get("email").(string)
+
+	assignments := []AccountUserAssignment{}
+	if d.HasChange("role_ids") {
+		assignments = append(assignments, AccountUserAssignment{
+			AccountID: accountID,
+			Email:     email,
+			RoleIds:   expandAccountUserRoleIDs(d.Get("role_ids").(*schema.Set)),
+		})
+	}
+	if d.HasChange("sub_account_assignment") {
+		oldAssignments, newAssignments := d.GetChange("sub_account_assignment")
+		assignments = append(assignments, getAccountUserSubAccountAssignments(email, oldAssignments.(*schema.Set).List(), newAssignments.(*schema.Set).List())...)
+	}
+
+	if len(assignments) > 0 {
+		err := client.AssignAccountUserRoles(assignments)
+		if err != nil {
+			log.Printf("[ERROR] Could not update the roles of Incapsula user %s: %s\n", email, err)
+			return err
+		}
+	}
+
+	return resourceAccountUserRead(d, m)
+}
+
+func resourceAccountUserDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+	accountID := d.Get("account_id").(int)
+	email := d.Get("email").(string)
+
+	err := client.DeleteAccountUser(accountID, email)
+	if err != nil {
+		log.Printf("[ERROR] Could not delete Incapsula user %s of account %d: %s\n", email, accountID, err)
+		return err
+	}
+
+	// Set the ID to empty
+	// Implicitly clears the resource
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const accountUserResource = "incapsula_account_user"
+const accountUserName = "testacc-terraform-account-user"
+const accountUserResourceName = accountUserResource + "." + accountUserName
+
+func TestAccIncapsulaAccountUser_Basic(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[DEBUG] Running test resource_account_user_test.TestAccIncapsulaAccountUser_Basic")
+	email := GenerateTestEmail(t)
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIncapsulaAccountUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaAccountUserConfig(email),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaAccountUserExists(accountUserResourceName),
+					resource.TestCheckResourceAttr(accountUserResourceName, "email", email),
+					resource.TestCheckResourceAttr(accountUserResourceName, "role_ids.#", "1"),
+				),
+			},
+			{
+				ResourceName:      accountUserResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestGetAccountUserSubAccountAssignments(t *testing.T) {
+	assignment := func(subAccountID int, roleIDs ...interface{}) interface{} {
+		return map[string]interface{}{"sub_account_id": subAccountID, "role_ids": schema.NewSet(schema.HashInt, roleIDs)}
+	}
+	oldAssignments := []interface{}{assignment(1, 10), assignment(2, 20)}
+	newAssignments := []interface{}{assignment(3, 31, 30), assignment(1, 11)}
+
+	assignments := getAccountUserSubAccountAssignments("jane@example.com", oldAssignments, newAssignments)
+	expected := "[{1 jane@example.com [11]} {2 jane@example.com []} {3 jane@example.com [30 31]}]"
+	if fmt.Sprint(assignments) != expected {
+		t.Errorf("Expected %s, got: %v", expected, assignments)
+	}
+}
+
+func testCheckIncapsulaAccountUserExists(name string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		res, ok := state.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Incapsula account user resource not found: %s", name)
+		}
+
+		accountID, _ := strconv.Atoi(res.Primary.Attributes["account_id"])
+		email := res.Primary.Attributes["email"]
+
+		client := testAccProvider.Meta().(*Client)
+		user, err := client.GetAccountUser(accountID, email)
+		if err != nil || user == nil {
+			return fmt.Errorf("Incapsula account user %s does not exist: %v", email, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIncapsulaAccountUserDestroy(state *terraform.State) error {
+	client := testAccProvider.Meta().(*Client)
+
+	for _, res := range state.RootModule().Resources {
+		if res.Type != accountUserResource {
+			continue
+		}
+
+		accountID, _ := strconv.Atoi(res.Primary.Attributes["account_id"])
+		email := res.Primary.Attributes["email"]
+		user, err := client.GetAccountUser(accountID, email)
+		if err != nil {
+			return err
+		}
+		if user != nil {
+			return fmt.Errorf("Incapsula account user %s still exists", email)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIncapsulaAccountUserConfig(email string) string {
+	return testAccCheckIncapsulaAccountRoleConfig("Reads the audit trail", `"canViewAuditTrail"`) + fmt.Sprintf(`
+resource "%s" "%s" {
+  email      = "%s"
+  first_name = "Jane"
+  last_name  = "%s"
+  role_ids   = [%s.id]
+}`, accountUserResource, accountUserName, email, accountUserName, accountRoleResourceName,
+	)
+}
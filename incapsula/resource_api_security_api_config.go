@@ -1,6 +1,7 @@
 package incapsula
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -40,84 +41,158 @@ func resourceApiSecurityApiConfig() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			return resourceApiSecurityAPIConfigCustomizeDiff(d, m)
+		},
 
-		Schema: map[string]*schema.Schema{
-			// Required Arguments
-			"site_id": {
-				Description: "Numeric identifier of the site to operate on. ",
-				Type:        schema.TypeInt,
-				Required:    true,
-				ForceNew:    true,
-			},
-			"api_specification": {
-				Description: "The API specification document content. The supported format is OAS2 or OAS3",
-				Type:        schema.TypeString,
-				Required:    true,
-			},
+		Schema: resourceApiSecurityAPIConfigSchema(),
+	}
+}
 
-			//Optional
-			"invalid_url_violation_action": {
-				Description:  "The violation action taken when invalid URL was used. Possible values: ALERT_ONLY, BLOCK_REQUEST, BLOCK_USER, BLOCK_IP, IGNORE, DEFAULT. Assigning DEFAULT will inherit the action from parent object",
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "DEFAULT",
-				ValidateFunc: validation.StringInSlice([]string{"ALERT_ONLY", "BLOCK_REQUEST", "BLOCK_USER", "BLOCK_IP", "IGNORE", "DEFAULT"}, false),
-			},
-			"invalid_method_violation_action": {
-				Description:  "The action taken when an invalid method Violation occurs. Possible values: ALERT_ONLY, BLOCK_REQUEST, BLOCK_USER, BLOCK_IP, IGNORE, DEFAULT. Assigning DEFAULT will inherit the action from parent object",
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "DEFAULT",
-				ValidateFunc: validation.StringInSlice([]string{"ALERT_ONLY", "BLOCK_REQUEST", "BLOCK_USER", "BLOCK_IP", "IGNORE", "DEFAULT"}, false),
-			},
-			"missing_param_violation_action": {
-				Description:  "The action taken when a missing parameter Violation occurs. Possible values: ALERT_ONLY, BLOCK_REQUEST, BLOCK_USER, BLOCK_IP, IGNORE, DEFAULT. Assigning DEFAULT will inherit the action from parent object",
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "DEFAULT",
-				ValidateFunc: validation.StringInSlice([]string{"ALERT_ONLY", "BLOCK_REQUEST", "BLOCK_USER", "BLOCK_IP", "IGNORE", "DEFAULT"}, false),
-			},
-			"invalid_param_value_violation_action": {
-				Description:  "The action taken when an invalid parameter value Violation occurs. Possible values: ALERT_ONLY, BLOCK_REQUEST, BLOCK_USER, BLOCK_IP, IGNORE, DEFAULT. Assigning DEFAULT will inherit the action from parent object",
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "DEFAULT",
-				ValidateFunc: validation.StringInSlice([]string{"ALERT_ONLY", "BLOCK_REQUEST", "BLOCK_USER", "BLOCK_IP", "IGNORE", "DEFAULT"}, false),
-			},
-			"invalid_param_name_violation_action": {
-				Description:  "The violation action taken when invalid request parameter name was sent. Possible values: ALERT_ONLY, BLOCK_REQUEST, BLOCK_USER, BLOCK_IP, IGNORE, DEFAULT. Assigning DEFAULT will inherit the action from parent object",
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "DEFAULT",
-				ValidateFunc: validation.StringInSlice([]string{"ALERT_ONLY", "BLOCK_REQUEST", "BLOCK_USER", "BLOCK_IP", "IGNORE", "DEFAULT"}, false),
-			},
+func resourceApiSecurityAPIConfigSchema() map[string]*schema.Schema {
+	resourceSchema := map[string]*schema.Schema{
+		// Required Arguments
+		"site_id": {
+			Description: "Numeric identifier of the site to operate on. ",
+			Type:        schema.TypeInt,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"api_specification": {
+			Description:      "The API specification document content. The supported format is OAS2 or OAS3, in JSON or YAML",
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateFunc:     validateApiSpecification,
+			DiffSuppressFunc: suppressEquivalentApiSpecificationDiffs,
+		},
 
-			"description": {
-				Description: "A description that will help recognize the API in the dashboard",
-				Type:        schema.TypeString,
-				Optional:    true,
-			},
+		//Optional
+		"invalid_url_violation_action": {
+			Description:  "The violation action taken when invalid URL was used. Possible values: ALERT_ONLY, BLOCK_REQUEST, BLOCK_USER, BLOCK_IP, IGNORE, DEFAULT. Assigning DEFAULT will inherit the action from parent object",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Deprecated:   "Use violation_actions instead",
+			ValidateFunc: validation.StringInSlice([]string{"ALERT_ONLY", "BLOCK_REQUEST", "BLOCK_USER", "BLOCK_IP", "IGNORE", "DEFAULT"}, false),
+		},
+		"invalid_method_violation_action": {
+			Description:  "The action taken when an invalid method Violation occurs. Possible values: ALERT_ONLY, BLOCK_REQUEST, BLOCK_USER, BLOCK_IP, IGNORE, DEFAULT. Assigning DEFAULT will inherit the action from parent object",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Deprecated:   "Use violation_actions instead",
+			ValidateFunc: validation.StringInSlice([]string{"ALERT_ONLY", "BLOCK_REQUEST", "BLOCK_USER", "BLOCK_IP", "IGNORE", "DEFAULT"}, false),
+		},
+		"missing_param_violation_action": {
+			Description:  "The action taken when a missing parameter Violation occurs. Possible values: ALERT_ONLY, BLOCK_REQUEST, BLOCK_USER, BLOCK_IP, IGNORE, DEFAULT. Assigning DEFAULT will inherit the action from parent object",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Deprecated:   "Use violation_actions instead",
+			ValidateFunc: validation.StringInSlice([]string{"ALERT_ONLY", "BLOCK_REQUEST", "BLOCK_USER", "BLOCK_IP", "IGNORE", "DEFAULT"}, false),
+		},
+		"invalid_param_value_violation_action": {
+			Description:  "The action taken when an invalid parameter value Violation occurs. Possible values: ALERT_ONLY, BLOCK_REQUEST, BLOCK_USER, BLOCK_IP, IGNORE, DEFAULT. Assigning DEFAULT will inherit the action from parent object",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Deprecated:   "Use violation_actions instead",
+			ValidateFunc: validation.StringInSlice([]string{"ALERT_ONLY", "BLOCK_REQUEST", "BLOCK_USER", "BLOCK_IP", "IGNORE", "DEFAULT"}, false),
+		},
+		"invalid_param_name_violation_action": {
+			Description:  "The violation action taken when invalid request parameter name was sent. Possible values: ALERT_ONLY, BLOCK_REQUEST, BLOCK_USER, BLOCK_IP, IGNORE, DEFAULT. Assigning DEFAULT will inherit the action from parent object",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Deprecated:   "Use violation_actions instead",
+			ValidateFunc: validation.StringInSlice([]string{"ALERT_ONLY", "BLOCK_REQUEST", "BLOCK_USER", "BLOCK_IP", "IGNORE", "DEFAULT"}, false),
+		},
 
-			"base_path": {
-				Description: "Override the spec basePath / server base path with this value",
-				Type:        schema.TypeString,
-				Optional:    true,
-				Computed:    true,
-			},
+		"description": {
+			Description: "A description that will help recognize the API in the dashboard",
+			Type:        schema.TypeString,
+			Optional:    true,
+		},
 
-			"host_name": {
-				Description: "The host name from the swagger file",
-				Type:        schema.TypeString,
-				Computed:    true,
-			},
+		"base_path": {
+			Description: "Override the spec basePath / server base path with this value",
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+		},
 
-			"last_modified": {
-				Description: "The last modified timestamp",
-				Type:        schema.TypeInt,
-				Computed:    true,
-			},
+		"host_name": {
+			Description: "The host name from the swagger file",
+			Type:        schema.TypeString,
+			Computed:    true,
+		},
+
+		"endpoint_keys": {
+			Description: "The endpoints declared in the specification, as \"METHOD path\" keys. Known at plan time, to be used in for_each",
+			Type:        schema.TypeSet,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+
+		"endpoints": apiSecurityEndpointsSchema("The endpoints of the API with their violation actions, keyed as endpoint_keys"),
+
+		"last_modified": {
+			Description: "The last modified timestamp",
+			Type:        schema.TypeInt,
+			Computed:    true,
 		},
+
+		"violation_actions": apiSecurityViolationActionsSchema(apiSecurityViolationActionFields, true),
 	}
+	apiSecurityEffectiveViolationActionsSchema(resourceSchema, apiSecurityViolationActionFields)
+
+	return resourceSchema
+}
+
+// resourceApiSecurityAPIConfigCustomizeDiff plans the effective violation actions, and derives host_name, base_path
+// and endpoint_keys from a new specification, so they are shown in the plan.
+// A base_path set in the configuration overrides the specification.
+func resourceApiSecurityAPIConfigCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	violationActions := getApiSecurityAPIConfigViolationActions(d)
+	if err := setApiSecurityEffectiveViolationActionsDiff(d, apiSecurityViolationActionFields, violationActions.toMap()); err != nil {
+		return err
+	}
+
+	if !d.NewValueKnown("api_specification") || (d.Id() != "" && !d.HasChange("api_specification")) {
+		return nil
+	}
+
+	spec, err := parseApiSpecification(d.Get("api_specification").(string))
+	if err != nil {
+		return err
+	}
+	hostName, basePath := getApiSpecificationHostAndBasePath(spec)
+
+	if err := d.SetNew("endpoint_keys", getApiSpecificationEndpointKeys(spec)); err != nil {
+		return err
+	}
+	if err := d.SetNewComputed("endpoints"); err != nil {
+		return err
+	}
+
+	if hostName != "" {
+		err = d.SetNew("host_name", hostName)
+	} else {
+		err = d.SetNewComputed("host_name")
+	}
+	if err != nil {
+		return err
+	}
+
+	if rawConfig := d.GetRawConfig(); rawConfig.IsNull() || rawConfig.GetAttr("base_path").IsNull() {
+		if basePath != "" {
+			err = d.SetNew("base_path", basePath)
+		} else {
+			err = d.SetNewComputed("base_path")
+		}
+	}
+
+	return err
 }
 
 func resourceApiSecurityAPIConfigCreate(d *schema.ResourceData, m interface{}) error {
@@ -127,14 +202,8 @@ func resourceApiSecurityAPIConfigCreate(d *schema.ResourceData, m interface{}) e
 		ValidateHost:     false,
 		Description:      d.Get("description").(string),
 		ApiSpecification: d.Get("api_specification").(string),
-		BasePath:         d.Get("base_path").(string),
-		ViolationActions: ViolationActions{
-			InvalidUrlViolationAction:        d.Get("invalid_url_violation_action").(string),
-			InvalidMethodViolationAction:     d.Get("invalid_method_violation_action").(string),
-			MissingParamViolationAction:      d.Get("missing_param_violation_action").(string),
-			InvalidParamNameViolationAction:  d.Get("invalid_param_name_violation_action").(string),
-			InvalidParamValueViolationAction: d.Get("invalid_param_value_violation_action").(string),
-		},
+		BasePath:         getApiSecurityAPIConfigBasePathOverride(d),
+		ViolationActions: getApiSecurityAPIConfigViolationActions(d),
 	}
 
 	apiSecurityApiConfigPostResponse, err := client.CreateApiSecurityApiConfig(
@@ -160,13 +229,8 @@ func resourceApiSecurityAPIConfigUpdate(d *schema.ResourceData, m interface{}) e
 		ValidateHost:     false,
 		Description:      d.Get("description").(string),
 		ApiSpecification: d.Get("api_specification").(string),
-		ViolationActions: ViolationActions{
-			InvalidUrlViolationAction:        d.Get("invalid_url_violation_action").(string),
-			InvalidMethodViolationAction:     d.Get("invalid_method_violation_action").(string),
-			MissingParamViolationAction:      d.Get("missing_param_violation_action").(string),
-			InvalidParamNameViolationAction:  d.Get("invalid_param_name_violation_action").(string),
-			InvalidParamValueViolationAction: d.Get("invalid_param_value_violation_action").(string),
-		},
+		BasePath:         getApiSecurityAPIConfigBasePathOverride(d),
+		ViolationActions: getApiSecurityAPIConfigViolationActions(d),
 	}
 
 	_, err := client.UpdateApiSecurityApiConfig(
@@ -183,6 +247,25 @@ func resourceApiSecurityAPIConfigUpdate(d *schema.ResourceData, m interface{}) e
 	return resourceApiSecurityAPIConfigRead(d, m)
 }
 
+func getApiSecurityAPIConfigViolationActions(d apiSecurityViolationActionsGetter) ViolationActions {
+	return ViolationActions{
+		InvalidUrlViolationAction:        getApiSecurityViolationAction(d, "invalid_url_violation_action", apiSecurityDefaultViolationAction),
+		InvalidMethodViolationAction:     getApiSecurityViolationAction(d, "invalid_method_violation_action", apiSecurityDefaultViolationAction),
+		MissingParamViolationAction:      getApiSecurityViolationAction(d, "missing_param_violation_action", apiSecurityDefaultViolationAction),
+		InvalidParamNameViolationAction:  getApiSecurityViolationAction(d, "invalid_param_name_violation_action", apiSecurityDefaultViolationAction),
+		InvalidParamValueViolationAction: getApiSecurityViolationAction(d, "invalid_param_value_violation_action", apiSecurityDefaultViolationAction),
+	}
+}
+
+// getApiSecurityAPIConfigBasePathOverride returns the base_path only when it is set in the configuration,
+// otherwise the base path of the specification is used
+func getApiSecurityAPIConfigBasePathOverride(d *schema.ResourceData) string {
+	if rawConfig := d.GetRawConfig(); rawConfig.IsNull() || rawConfig.GetAttr("base_path").IsNull() {
+		return ""
+	}
+	return d.Get("base_path").(string)
+}
+
 func resourceApiSecurityAPIConfigRead(d *schema.ResourceData, m interface{}) error {
 	client := m.(*Client)
 	siteID := d.Get("site_id").(int)
@@ -205,11 +288,17 @@ func resourceApiSecurityAPIConfigRead(d *schema.ResourceData, m interface{}) err
 	d.Set("base_path", apiSecurityApiConfigGetResponse.Value.BasePath)
 	d.Set("description", apiSecurityApiConfigGetResponse.Value.Description)
 	d.Set("last_modified", apiSecurityApiConfigGetResponse.Value.LastModified)
-	d.Set("invalid_method_violation_action", apiSecurityApiConfigGetResponse.Value.ViolationActions.InvalidMethodViolationAction)
-	d.Set("invalid_url_violation_action", apiSecurityApiConfigGetResponse.Value.ViolationActions.InvalidUrlViolationAction)
-	d.Set("missing_param_violation_action", apiSecurityApiConfigGetResponse.Value.ViolationActions.MissingParamViolationAction)
-	d.Set("invalid_param_name_violation_action", apiSecurityApiConfigGetResponse.Value.ViolationActions.InvalidParamNameViolationAction)
-	d.Set("invalid_param_value_violation_action", apiSecurityApiConfigGetResponse.Value.ViolationActions.InvalidParamValueViolationAction)
+	violationActions := apiSecurityApiConfigGetResponse.Value.ViolationActions.toMap()
+	setApiSecurityViolationActions(d, violationActions)
+
+	parents, err := getApiSecurityParentViolationActions(client, siteID, 0)
+	if err != nil {
+		log.Printf("[ERROR] Could not get Incapsula API Security site configuration: %d - %s\n", siteID, err)
+		return err
+	}
+	for field, action := range resolveApiSecurityEffectiveViolationActions(apiSecurityViolationActionFields, violationActions, parents) {
+		d.Set("effective_"+field, action)
+	}
 
 	apiSecurityApiConfigGetFileResponse, err := client.GetApiSecurityApiSwaggerConfig(siteID, apiID)
 	if err != nil {
@@ -218,6 +307,30 @@ func resourceApiSecurityAPIConfigRead(d *schema.ResourceData, m interface{}) err
 	}
 	d.Set("api_specification", apiSecurityApiConfigGetFileResponse.Value)
 
+	endpointsGetAllResponse, err := client.GetApiSecurityAllEndpointsConfig(apiID)
+	if err != nil {
+		log.Printf("[ERROR] Could not get Incapsula API Security API endpoints: %d - %s\n", apiID, err)
+		return err
+	}
+
+	// The endpoint keys are derived from the specification, as in the plan
+	var endpointKeys []string
+	if spec, err := parseApiSpecification(apiSecurityApiConfigGetFileResponse.Value); err == nil {
+		endpointKeys = getApiSpecificationEndpointKeys(spec)
+	} else {
+		log.Printf("[WARN] Could not parse Incapsula API Security API swagger file: %d - %s\n", apiID, err)
+		for _, endpoint := range endpointsGetAllResponse.Value {
+			endpointKeys = append(endpointKeys, apiSecurityEndpointKey(endpoint.Method, endpoint.Path))
+		}
+	}
+	keys := &apiSecurityEndpointKeys{basePath: apiSecurityApiConfigGetResponse.Value.BasePath, specificationKeys: endpointKeys}
+	endpoints := make([]interface{}, 0, len(endpointsGetAllResponse.Value))
+	for _, endpoint := range endpointsGetAllResponse.Value {
+		endpoints = append(endpoints, flattenApiSecurityEndpoint(endpoint, keys.key(endpoint.Method, endpoint.Path)))
+	}
+	d.Set("endpoint_keys", endpointKeys)
+	d.Set("endpoints", endpoints)
+
 	return nil
 }
 
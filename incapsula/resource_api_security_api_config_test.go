@@ -33,6 +33,9 @@ func TestAccIncapsulaApiSecurityApiConfig_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(apiSecApiConfigResource, "missing_param_violation_action", "IGNORE"),
 					resource.TestCheckResourceAttr(apiSecApiConfigResource, "invalid_param_value_violation_action", "DEFAULT"),
 					resource.TestCheckResourceAttr(apiSecApiConfigResource, "invalid_param_name_violation_action", "DEFAULT"),
+					resource.TestCheckResourceAttr(apiSecApiConfigResource, "host_name", "api.example.com"),
+					resource.TestCheckResourceAttr(apiSecApiConfigResource, "endpoint_keys.#", "1"),
+					resource.TestCheckResourceAttr(apiSecApiConfigResource, "endpoints.0.key", "GET /users"),
 				),
 			},
 			{
@@ -1,7 +1,9 @@
 package incapsula
 
 import (
+	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
@@ -12,7 +14,12 @@ import (
 func resourceApiSecurityEndpointConfig() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceApiSecurityEndpointConfigCreate,
-		Read:   resourceApiSecurityEndpointConfigRead,
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			if err := resourceApiSecurityEndpointConfigRead(d, m); err != nil {
+				return diag.FromErr(err)
+			}
+			return getApiSecurityEndpointConfigUnresolvedDiagnostics(d)
+		},
 		Update: resourceApiSecurityEndpointConfigUpdate,
 		Delete: resourceApiSecurityEndpointConfigDelete,
 		Importer: &schema.ResourceImporter{
@@ -39,53 +46,89 @@ func resourceApiSecurityEndpointConfig() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
-		Schema: map[string]*schema.Schema{
-			// Required Arguments
-			"api_id": {
-				Description: "The site ID which API security is configured on.",
-				Type:        schema.TypeInt,
-				Required:    true,
-				ForceNew:    true,
-			},
-			"method": {
-				Description: "HTTP method that describes a specific endpoint. Possible values: POST, GET, PUT, PATCH, DELETE, HEAD, OPTIONS",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-			},
-			"path": {
-				Description: "An URL path of specific endpoint ",
-				Type:        schema.TypeString,
-				Required:    true,
-				ForceNew:    true,
-			},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			return resourceApiSecurityEndpointConfigCustomizeDiff(d, m)
+		},
 
-			// Optional Arguments
-			"missing_param_violation_action": {
-				Description:  "The action taken when an invalid URL Violation occurs. Possible values: ALERT_ONLY, BLOCK_REQUEST, BLOCK_USER, BLOCK_IP, IGNORE, DEFAULT. Assigning DEFAULT will inherit the action from parent object.",
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "DEFAULT",
-				ValidateFunc: validation.StringInSlice([]string{"ALERT_ONLY", "BLOCK_REQUEST", "BLOCK_USER", "BLOCK_IP", "IGNORE", "DEFAULT"}, false),
-			},
+		Schema: resourceApiSecurityEndpointConfigSchema(),
+	}
+}
 
-			"invalid_param_value_violation_action": {
-				Description:  "The action taken when an invalid parameter value Violation occurs. Possible values: ALERT_ONLY, BLOCK_REQUEST, BLOCK_USER, BLOCK_IP, IGNORE, DEFAULT. Assigning DEFAULT will inherit the action from parent object.",
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "DEFAULT",
-				ValidateFunc: validation.StringInSlice([]string{"ALERT_ONLY", "BLOCK_REQUEST", "BLOCK_USER", "BLOCK_IP", "IGNORE", "DEFAULT"}, false),
-			},
+func resourceApiSecurityEndpointConfigSchema() map[string]*schema.Schema {
+	resourceSchema := map[string]*schema.Schema{
+		// Required Arguments
+		"api_id": {
+			Description: "The site ID which API security is configured on.",
+			Type:        schema.TypeInt,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"method": {
+			Description: "HTTP method that describes a specific endpoint. Possible values: POST, GET, PUT, PATCH, DELETE, HEAD, OPTIONS",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
+		"path": {
+			Description: "An URL path of specific endpoint ",
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+		},
 
-			"invalid_param_name_violation_action": {
-				Description:  "The action taken when an invalid parameter name Violation occurs. Possible values: ALERT_ONLY, BLOCK_REQUEST, BLOCK_USER, BLOCK_IP, IGNORE, DEFAULT. Assigning DEFAULT will inherit the action from parent object.",
-				Type:         schema.TypeString,
-				Optional:     true,
-				Default:      "DEFAULT",
-				ValidateFunc: validation.StringInSlice([]string{"ALERT_ONLY", "BLOCK_REQUEST", "BLOCK_USER", "BLOCK_IP", "IGNORE", "DEFAULT"}, false),
-			},
+		// Optional Arguments
+		"missing_param_violation_action": {
+			Description:  "The action taken when an invalid URL Violation occurs. Possible values: ALERT_ONLY, BLOCK_REQUEST, BLOCK_USER, BLOCK_IP, IGNORE, DEFAULT. Assigning DEFAULT will inherit the action from parent object.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Deprecated:   "Use violation_actions instead",
+			ValidateFunc: validation.StringInSlice([]string{"ALERT_ONLY", "BLOCK_REQUEST", "BLOCK_USER", "BLOCK_IP", "IGNORE", "DEFAULT"}, false),
+		},
+
+		"invalid_param_value_violation_action": {
+			Description:  "The action taken when an invalid parameter value Violation occurs. Possible values: ALERT_ONLY, BLOCK_REQUEST, BLOCK_USER, BLOCK_IP, IGNORE, DEFAULT. Assigning DEFAULT will inherit the action from parent object.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Deprecated:   "Use violation_actions instead",
+			ValidateFunc: validation.StringInSlice([]string{"ALERT_ONLY", "BLOCK_REQUEST", "BLOCK_USER", "BLOCK_IP", "IGNORE", "DEFAULT"}, false),
+		},
+
+		"invalid_param_name_violation_action": {
+			Description:  "The action taken when an invalid parameter name Violation occurs. Possible values: ALERT_ONLY, BLOCK_REQUEST, BLOCK_USER, BLOCK_IP, IGNORE, DEFAULT. Assigning DEFAULT will inherit the action from parent object.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Deprecated:   "Use violation_actions instead",
+			ValidateFunc: validation.StringInSlice([]string{"ALERT_ONLY", "BLOCK_REQUEST", "BLOCK_USER", "BLOCK_IP", "IGNORE", "DEFAULT"}, false),
+		},
+
+		"violation_actions": apiSecurityViolationActionsSchema(apiSecurityEndpointViolationActionFields, true),
+
+		"site_id": {
+			Description: "The site ID of the API. Used to resolve the effective violation actions inherited from the API and site configurations, and the path relative to the base path of the API.",
+			Type:        schema.TypeInt,
+			Optional:    true,
 		},
 	}
+	apiSecurityEffectiveViolationActionsSchema(resourceSchema, apiSecurityEndpointViolationActionFields)
+
+	return resourceSchema
+}
+
+// resourceApiSecurityEndpointConfigCustomizeDiff plans the effective violation actions
+func resourceApiSecurityEndpointConfigCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	violationActions := getApiSecurityEndpointConfigViolationActions(d)
+	return setApiSecurityEffectiveViolationActionsDiff(d, apiSecurityEndpointViolationActionFields, violationActions.toMap())
+}
+
+func getApiSecurityEndpointConfigViolationActions(d apiSecurityViolationActionsGetter) UserViolationActions {
+	return UserViolationActions{
+		MissingParamViolationAction:      getApiSecurityViolationAction(d, "missing_param_violation_action", apiSecurityDefaultViolationAction),
+		InvalidParamNameViolationAction:  getApiSecurityViolationAction(d, "invalid_param_name_violation_action", apiSecurityDefaultViolationAction),
+		InvalidParamValueViolationAction: getApiSecurityViolationAction(d, "invalid_param_value_violation_action", apiSecurityDefaultViolationAction),
+	}
 }
 
 func resourceApiSecurityEndpointConfigRead(d *schema.ResourceData, m interface{}) error {
@@ -97,30 +140,84 @@ func resourceApiSecurityEndpointConfigRead(d *schema.ResourceData, m interface{}
 		return err
 	}
 
-	d.Set("missing_param_violation_action", endpointGetResponse.Value.ViolationActions.MissingParamViolationAction)
-	d.Set("invalid_param_name_violation_action", endpointGetResponse.Value.ViolationActions.InvalidParamNameViolationAction)
-	d.Set("invalid_param_value_violation_action", endpointGetResponse.Value.ViolationActions.InvalidParamValueViolationAction)
+	violationActions := endpointGetResponse.Value.ViolationActions.toMap()
+	setApiSecurityViolationActions(d, violationActions)
 	d.Set("method", endpointGetResponse.Value.Method)
-	d.Set("path", endpointGetResponse.Value.Path)
+
+	// Without site_id, only the actions of the endpoint itself can be resolved, and the path is the path of the API
+	var keys *apiSecurityEndpointKeys
+	var parents []ViolationActions
+	if siteID := d.Get("site_id").(int); siteID != 0 {
+		keys, err = getApiSecurityEndpointKeys(client, siteID, d.Get("api_id").(int))
+		if err != nil {
+			log.Printf("[ERROR] Could not get Incapsula API-security API configuration of endpoint: %s - %s\n", d.Id(), err)
+			return err
+		}
+		parents, err = getApiSecurityParentViolationActions(client, siteID, d.Get("api_id").(int))
+		if err != nil {
+			log.Printf("[ERROR] Could not get Incapsula API-security parent configurations of endpoint: %s - %s\n", d.Id(), err)
+			return err
+		}
+	}
+	d.Set("path", keys.path(endpointGetResponse.Value.Method, endpointGetResponse.Value.Path))
+	for field, action := range resolveApiSecurityEffectiveViolationActions(apiSecurityEndpointViolationActionFields, violationActions, parents) {
+		// DEFAULT can't be resolved without the parent configurations
+		if parents == nil && action == apiSecurityDefaultViolationAction {
+			action = ""
+		}
+		d.Set("effective_"+field, action)
+	}
 	return nil
 }
 
+// getApiSecurityEndpointConfigUnresolvedDiagnostics warns when the inherited violation actions are unresolved without site_id
+func getApiSecurityEndpointConfigUnresolvedDiagnostics(d *schema.ResourceData) diag.Diagnostics {
+	if d.Id() == "" || d.Get("site_id").(int) != 0 {
+		return nil
+	}
+
+	unresolved := []string{}
+	for _, field := range apiSecurityEndpointViolationActionFields {
+		if d.Get("effective_"+field).(string) == "" {
+			unresolved = append(unresolved, "effective_"+field)
+		}
+	}
+	if len(unresolved) == 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Unresolved effective violation actions of API Security endpoint %s", d.Id()),
+		Detail: fmt.Sprintf("The violation actions %s of endpoint %s are DEFAULT, inherited from the API and site configurations, "+
+			"and are left empty: set site_id to resolve them.", strings.Join(unresolved, ", "), d.Id()),
+	}}
+}
+
 func resourceApiSecurityEndpointConfigCreate(d *schema.ResourceData, m interface{}) error {
 	client := m.(*Client)
-	endpointGetAllResponse, _ := client.GetApiSecurityAllEndpointsConfig(d.Get("api_id").(int))
-	var found bool
-	var endpointId string
-	for _, entry := range endpointGetAllResponse.Value {
-		if entry.Path == d.Get("path").(string) && entry.Method == d.Get("method").(string) {
-			endpointId = strconv.Itoa(entry.Id)
-			found = true
-			break
+	apiID := d.Get("api_id").(int)
+	siteID := d.Get("site_id").(int)
+	endpointGetAllResponse, err := client.GetApiSecurityAllEndpointsConfig(apiID)
+	if err != nil {
+		return err
+	}
+
+	// With site_id, the path can be relative to the base path, as in the endpoint_keys of the API configuration
+	var keys *apiSecurityEndpointKeys
+	if siteID != 0 {
+		keys, err = getApiSecurityEndpointKeys(client, siteID, apiID)
+		if err != nil {
+			log.Printf("[ERROR] Could not get Incapsula API-security API configuration: %d - %s\n", apiID, err)
+			return err
 		}
 	}
 
+	endpoint, found := findApiSecurityEndpoint(endpointGetAllResponse.Value, apiSecurityEndpointKey(d.Get("method").(string), d.Get("path").(string)), keys)
 	if !found {
 		return fmt.Errorf("[ERROR] API-security endpoint [%s %s] doesn't exist and will not be updated.", d.Get("method").(string), d.Get("path").(string))
 	}
+	endpointId := strconv.Itoa(endpoint.Id)
 	log.Printf("[DEBUG] found endpoint id %s", endpointId)
 	d.SetId(endpointId)
 
@@ -131,11 +228,7 @@ func resourceApiSecurityEndpointConfigUpdate(d *schema.ResourceData, m interface
 	client := m.(*Client)
 
 	payload := ApiSecurityEndpointConfigPostPayload{
-		ViolationActions: UserViolationActions{
-			MissingParamViolationAction:      d.Get("missing_param_violation_action").(string),
-			InvalidParamNameViolationAction:  d.Get("invalid_param_name_violation_action").(string),
-			InvalidParamValueViolationAction: d.Get("invalid_param_value_violation_action").(string),
-		},
+		ViolationActions: getApiSecurityEndpointConfigViolationActions(d),
 	}
 
 	endpointId, err := strconv.Atoi(d.Id())
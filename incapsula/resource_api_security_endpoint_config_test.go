@@ -3,9 +3,11 @@ package incapsula
 import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"log"
 	"strconv"
+	"strings"
 	"testing"
 )
 
@@ -110,3 +112,24 @@ depends_on=[%s]
 `, apiSecEndpointConfigResourceName, apiSecEndpointConfigName, apiSecApiConfigResource, apiSecApiConfigResource,
 	)
 }
+
+func TestGetApiSecurityEndpointConfigUnresolvedDiagnostics(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceApiSecurityEndpointConfig().Schema, map[string]interface{}{
+		"api_id": 1,
+		"method": "GET",
+		"path":   "/users",
+	})
+	d.SetId("2")
+	d.Set("effective_missing_param_violation_action", "BLOCK_IP")
+
+	diags := getApiSecurityEndpointConfigUnresolvedDiagnostics(d)
+	if len(diags) != 1 || !strings.Contains(diags[0].Detail, "effective_invalid_param_name_violation_action") ||
+		strings.Contains(diags[0].Detail, "effective_missing_param_violation_action") {
+		t.Errorf("Expected a warning about the unresolved actions, got: %v", diags)
+	}
+
+	d.Set("site_id", 3)
+	if diags := getApiSecurityEndpointConfigUnresolvedDiagnostics(d); len(diags) != 0 {
+		t.Errorf("Expected no warning with site_id, got: %v", diags)
+	}
+}
@@ -44,37 +44,43 @@ func resourceApiSecuritySiteConfig() *schema.Resource {
 				Description:  "The action taken when an invalid URL Violation occurs. Possible values: ALERT_ONLY, BLOCK_REQUEST, BLOCK_USER, BLOCK_IP, IGNORE.",
 				Type:         schema.TypeString,
 				Optional:     true,
+				Computed:     true,
+				Deprecated:   "Use violation_actions instead",
 				ValidateFunc: validation.StringInSlice([]string{"ALERT_ONLY", "BLOCK_REQUEST", "BLOCK_USER", "BLOCK_IP", "IGNORE"}, false),
-				Default:      "ALERT_ONLY",
 			},
 			"invalid_method_violation_action": {
 				Description:  "The action taken when an invalid method Violation occurs. Possible values: ALERT_ONLY, BLOCK_REQUEST, BLOCK_USER, BLOCK_IP, IGNORE, DEFAULT.",
 				Type:         schema.TypeString,
 				Optional:     true,
+				Computed:     true,
+				Deprecated:   "Use violation_actions instead",
 				ValidateFunc: validation.StringInSlice([]string{"ALERT_ONLY", "BLOCK_REQUEST", "BLOCK_USER", "BLOCK_IP", "IGNORE"}, false),
-				Default:      "ALERT_ONLY",
 			},
 			"missing_param_violation_action": {
 				Description:  "The action taken when a missing parameter Violation occurs. Possible values: ALERT_ONLY, BLOCK_REQUEST, BLOCK_USER, BLOCK_IP, IGNORE, DEFAULT.",
 				Type:         schema.TypeString,
 				Optional:     true,
+				Computed:     true,
+				Deprecated:   "Use violation_actions instead",
 				ValidateFunc: validation.StringInSlice([]string{"ALERT_ONLY", "BLOCK_REQUEST", "BLOCK_USER", "BLOCK_IP", "IGNORE"}, false),
-				Default:      "ALERT_ONLY",
 			},
 			"invalid_param_value_violation_action": {
 				Description:  "The action taken when an invalid parameter value Violation occurs. Possible values: ALERT_ONLY, BLOCK_REQUEST, BLOCK_USER, BLOCK_IP, IGNORE, DEFAULT.",
 				Type:         schema.TypeString,
 				Optional:     true,
+				Computed:     true,
+				Deprecated:   "Use violation_actions instead",
 				ValidateFunc: validation.StringInSlice([]string{"ALERT_ONLY", "BLOCK_REQUEST", "BLOCK_USER", "BLOCK_IP", "IGNORE"}, false),
-				Default:      "ALERT_ONLY",
 			},
 			"invalid_param_name_violation_action": {
 				Description:  "The action taken when an invalid parameter value Violation occurs. Possible values: ALERT_ONLY, BLOCK_REQUEST, BLOCK_USER, BLOCK_IP, IGNORE, DEFAULT.",
 				Type:         schema.TypeString,
 				Optional:     true,
+				Computed:     true,
+				Deprecated:   "Use violation_actions instead",
 				ValidateFunc: validation.StringInSlice([]string{"ALERT_ONLY", "BLOCK_REQUEST", "BLOCK_USER", "BLOCK_IP", "IGNORE"}, false),
-				Default:      "ALERT_ONLY",
 			},
+			"violation_actions": apiSecurityViolationActionsSchema(apiSecurityViolationActionFields, false),
 			"is_api_only_site": {
 				Description: "Apply positive security model for all traffic on the site. Applying the positive security model for all traffic on the site may lead to undesired request blocking.",
 				Type:        schema.TypeBool,
@@ -104,11 +110,11 @@ func resourceApiSecuritySiteConfigUpdate(d *schema.ResourceData, m interface{})
 		NonApiRequestViolationAction:              d.Get("non_api_request_violation_action").(string),
 		IsAutomaticDiscoveryApiIntegrationEnabled: d.Get("is_automatic_discovery_api_integration_enabled").(bool),
 		ViolationActions: ViolationActions{
-			InvalidUrlViolationAction:        d.Get("invalid_url_violation_action").(string),
-			InvalidMethodViolationAction:     d.Get("invalid_method_violation_action").(string),
-			MissingParamViolationAction:      d.Get("missing_param_violation_action").(string),
-			InvalidParamNameViolationAction:  d.Get("invalid_param_name_violation_action").(string),
-			InvalidParamValueViolationAction: d.Get("invalid_param_value_violation_action").(string),
+			InvalidUrlViolationAction:        getApiSecurityViolationAction(d, "invalid_url_violation_action", "ALERT_ONLY"),
+			InvalidMethodViolationAction:     getApiSecurityViolationAction(d, "invalid_method_violation_action", "ALERT_ONLY"),
+			MissingParamViolationAction:      getApiSecurityViolationAction(d, "missing_param_violation_action", "ALERT_ONLY"),
+			InvalidParamNameViolationAction:  getApiSecurityViolationAction(d, "invalid_param_name_violation_action", "ALERT_ONLY"),
+			InvalidParamValueViolationAction: getApiSecurityViolationAction(d, "invalid_param_value_violation_action", "ALERT_ONLY"),
 		},
 	}
 
@@ -139,11 +145,7 @@ func resourceApiSecuritySiteConfigRead(d *schema.ResourceData, m interface{}) er
 	}
 
 	// Set computed values
-	d.Set("invalid_method_violation_action", apiSecuritySiteConfigGetResponse.Value.ViolationActions.InvalidMethodViolationAction)
-	d.Set("invalid_param_name_violation_action", apiSecuritySiteConfigGetResponse.Value.ViolationActions.InvalidParamNameViolationAction)
-	d.Set("invalid_param_value_violation_action", apiSecuritySiteConfigGetResponse.Value.ViolationActions.InvalidParamValueViolationAction)
-	d.Set("invalid_url_violation_action", apiSecuritySiteConfigGetResponse.Value.ViolationActions.InvalidUrlViolationAction)
-	d.Set("missing_param_violation_action", apiSecuritySiteConfigGetResponse.Value.ViolationActions.MissingParamViolationAction)
+	setApiSecurityViolationActions(d, apiSecuritySiteConfigGetResponse.Value.ViolationActions.toMap())
 	d.Set("non_api_request_violation_action", apiSecuritySiteConfigGetResponse.Value.NonApiRequestViolationAction)
 	d.Set("is_automatic_discovery_api_integration_enabled", apiSecuritySiteConfigGetResponse.Value.IsAutomaticDiscoveryApiIntegrationEnabled)
 	d.Set("is_api_only_site", apiSecuritySiteConfigGetResponse.Value.ApiOnlySite)
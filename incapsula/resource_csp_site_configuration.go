@@ -1,10 +1,13 @@
 package incapsula
 
 import (
+	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
+	"sort"
 	"strconv"
 	"strings"
 )
@@ -18,7 +21,12 @@ const (
 func resourceCSPSiteConfiguration() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceCSPSiteConfigurationUpdate,
-		Read:   resourceCSPSiteConfigurationRead,
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			if err := resourceCSPSiteConfigurationRead(d, m); err != nil {
+				return diag.FromErr(err)
+			}
+			return getCSPUnexpectedTrackingIDsDiagnostics(d)
+		},
 		Update: resourceCSPSiteConfigurationUpdate,
 		Delete: resourceCSPSiteConfigurationDelete,
 		Importer: &schema.ResourceImporter{
@@ -74,10 +82,83 @@ func resourceCSPSiteConfiguration() *schema.Resource {
 				},
 				Optional: true,
 			},
+			"expected_tracking_ids": {
+				Description: "The analytics tracking IDs expected on the site. A warning is shown when other tracking IDs are discovered",
+				Type:        schema.TypeSet,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional: true,
+			},
+			// Computed Attributes
+			"tracking_ids": {
+				Description: "The analytics tracking IDs discovered on the site",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tracking_id": {
+							Description: "The tracking ID",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						// A number, as TypeInt is a 32-bit int on 32-bit platforms, the milliseconds are exact up to 2^53
+						"discovered_ms": {
+							Description: "The time the tracking ID was discovered, in milliseconds since epoch",
+							Type:        schema.TypeFloat,
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"unexpected_tracking_ids": {
+				Description: "The discovered tracking IDs which are not in expected_tracking_ids. Empty when expected_tracking_ids is not set",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
 
+// getCSPUnexpectedTrackingIDs returns the sorted discovered tracking IDs which are not expected
+func getCSPUnexpectedTrackingIDs(trackingIDs []CSPTrackingID, expected *schema.Set) []string {
+	unexpected := []string{}
+	if expected == nil || expected.Len() == 0 {
+		return unexpected
+	}
+
+	for _, trackingID := range trackingIDs {
+		if !expected.Contains(trackingID.TrackingId) {
+			unexpected = append(unexpected, trackingID.TrackingId)
+		}
+	}
+	sort.Strings(unexpected)
+
+	return unexpected
+}
+
+// getCSPUnexpectedTrackingIDsDiagnostics warns about unexpected tracking IDs, the warning is shown in plan
+func getCSPUnexpectedTrackingIDsDiagnostics(d *schema.ResourceData) diag.Diagnostics {
+	unexpected := d.Get("unexpected_tracking_ids").([]interface{})
+	if len(unexpected) == 0 {
+		return nil
+	}
+
+	trackingIDs := make([]string, 0, len(unexpected))
+	for _, trackingID := range unexpected {
+		trackingIDs = append(trackingIDs, trackingID.(string))
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("Unexpected CSP tracking IDs discovered on site %d", d.Get("site_id").(int)),
+		Detail:   fmt.Sprintf("The following tracking IDs are not in expected_tracking_ids: %s", strings.Join(trackingIDs, ", ")),
+	}}
+}
+
 func resourceCSPSiteConfigurationRead(d *schema.ResourceData, m interface{}) error {
 	client := m.(*Client)
 	siteID := d.Get("site_id").(int)
@@ -98,6 +179,16 @@ func resourceCSPSiteConfigurationRead(d *schema.ResourceData, m interface{}) err
 	}
 	d.Set("email_addresses", emails)
 
+	trackingIDs := make([]interface{}, 0, len(cspSite.TrackingIDs))
+	for _, trackingID := range cspSite.TrackingIDs {
+		trackingIDs = append(trackingIDs, map[string]interface{}{
+			"tracking_id":   trackingID.TrackingId,
+			"discovered_ms": float64(trackingID.DiscoveredMS),
+		})
+	}
+	d.Set("tracking_ids", trackingIDs)
+	d.Set("unexpected_tracking_ids", getCSPUnexpectedTrackingIDs(cspSite.TrackingIDs, d.Get("expected_tracking_ids").(*schema.Set)))
+
 	switch {
 	case strings.Compare(cspSite.Discovery, CSPDiscoveryOff) == 0:
 		d.Set("mode", cspSiteModeOff)
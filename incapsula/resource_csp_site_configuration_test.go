@@ -3,6 +3,7 @@ package incapsula
 import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"log"
 	"strconv"
@@ -153,3 +154,21 @@ func testAccCheckCSPSiteConfigBasic(t *testing.T) string {
 		cspSiteConfigResourceType, cspSiteConfigName, siteResourceName, siteResourceName, siteResourceName,
 	)
 }
+
+func TestGetCSPUnexpectedTrackingIDs(t *testing.T) {
+	trackingIDs := []CSPTrackingID{
+		{TrackingId: "UA-2", DiscoveredMS: 2},
+		{TrackingId: "UA-1", DiscoveredMS: 1},
+		{TrackingId: "G-3", DiscoveredMS: 3},
+	}
+
+	unexpected := getCSPUnexpectedTrackingIDs(trackingIDs, schema.NewSet(schema.HashString, []interface{}{"UA-1"}))
+	if strings.Join(unexpected, ",") != "G-3,UA-2" {
+		t.Errorf("Expected G-3 and UA-2 to be unexpected, got %v", unexpected)
+	}
+
+	unexpected = getCSPUnexpectedTrackingIDs(trackingIDs, schema.NewSet(schema.HashString, nil))
+	if len(unexpected) != 0 {
+		t.Errorf("Expected no unexpected tracking IDs without expected_tracking_ids, got %v", unexpected)
+	}
+}
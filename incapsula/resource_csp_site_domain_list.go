@@ -0,0 +1,246 @@
+package incapsula
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Number of pre-approved domains added or deleted concurrently
+const cspSiteDomainListParallelism = 10
+
+func resourceCSPSiteDomainList() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCSPSiteDomainListUpdate,
+		Read:   resourceCSPSiteDomainListRead,
+		Update: resourceCSPSiteDomainListUpdate,
+		Delete: resourceCSPSiteDomainListDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				keyParts := strings.Split(d.Id(), "/")
+				if len(keyParts) != 2 {
+					return nil, fmt.Errorf("Error parsing ID, actual value: %s, expected account_id/site_id\n", d.Id())
+				}
+				accountID, err := strconv.Atoi(keyParts[0])
+				if err != nil {
+					return nil, fmt.Errorf("failed to convert account ID from import command, actual value: %s, expected numeric id", keyParts[0])
+				}
+				siteID, err := strconv.Atoi(keyParts[1])
+				if err != nil {
+					return nil, fmt.Errorf("failed to convert site ID from import command, actual value: %s, expected numeric id", keyParts[1])
+				}
+
+				d.Set("account_id", accountID)
+				d.Set("site_id", siteID)
+				log.Printf("[DEBUG] Import CSP pre-approved domains list for site ID %d", siteID)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"site_id": {
+				Description: "Numeric identifier of the site to operate on.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			// Optional Arguments
+			"account_id": {
+				Description: "Numeric identifier of the account to operate on.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				ForceNew:    true,
+			},
+			"domain": {
+				Description: "The pre-approved domains of the site. Domains which are not listed are removed from the pre-approved list.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The fully qualified domain name. For example: www.example.com, hello.example.com.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"include_subdomains": {
+							Description: "Defines Whether or not subdomains will inherit the allowance of the parent domain. Values: true, false",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// getCSPSiteDomainListDeltas returns the domains to add or update, and the references of the domains to delete
+func getCSPSiteDomainListDeltas(current, desired []CSPPreApprovedDomain) ([]CSPPreApprovedDomain, []string) {
+	currentByDomain := make(map[string]CSPPreApprovedDomain, len(current))
+	for _, dom := range current {
+		currentByDomain[dom.Domain] = dom
+	}
+	desiredByDomain := make(map[string]bool, len(desired))
+
+	updates := []CSPPreApprovedDomain{}
+	for _, dom := range desired {
+		desiredByDomain[dom.Domain] = true
+		if currentDom, ok := currentByDomain[dom.Domain]; !ok || currentDom.Subdomains != dom.Subdomains {
+			updates = append(updates, dom)
+		}
+	}
+
+	deletes := []string{}
+	for _, dom := range current {
+		if !desiredByDomain[dom.Domain] {
+			domainRef := dom.ReferenceID
+			if domainRef == "" {
+				domainRef = base64.RawURLEncoding.EncodeToString([]byte(dom.Domain))
+			}
+			deletes = append(deletes, domainRef)
+		}
+	}
+	sort.Strings(deletes)
+
+	return updates, deletes
+}
+
+// applyCSPSiteDomainListDeltas adds, updates and deletes the pre-approved domains concurrently
+func applyCSPSiteDomainListDeltas(client *Client, accountID, siteID int, updates []CSPPreApprovedDomain, deletes []string) error {
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	var errs []string
+	semaphore := make(chan struct{}, cspSiteDomainListParallelism)
+
+	run := func(op func() error) {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			if err := op(); err != nil {
+				mutex.Lock()
+				errs = append(errs, strings.TrimSpace(err.Error()))
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	for i := range updates {
+		dom := updates[i]
+		run(func() error {
+			_, err := client.updateCSPPreApprovedDomain(accountID, siteID, &dom)
+			return err
+		})
+	}
+	for _, domainRef := range deletes {
+		domainRef := domainRef
+		run(func() error {
+			return client.deleteCSPPreApprovedDomains(accountID, siteID, domainRef)
+		})
+	}
+	wg.Wait()
+
+	if len(errs) > 0 {
+		sort.Strings(errs)
+		return fmt.Errorf("Error updating CSP pre-approved domains list for site ID %d:\n%s", siteID, strings.Join(errs, "\n"))
+	}
+
+	return nil
+}
+
+func resourceCSPSiteDomainListUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+	accountID := d.Get("account_id").(int)
+	siteID := d.Get("site_id").(int)
+
+	desired := []CSPPreApprovedDomain{}
+	for _, item := range d.Get("domain").(*schema.Set).List() {
+		domMap := item.(map[string]interface{})
+		domain := domMap["name"].(string)
+		desired = append(desired, CSPPreApprovedDomain{
+			Domain:      domain,
+			Subdomains:  domMap["include_subdomains"].(bool),
+			ReferenceID: base64.RawURLEncoding.EncodeToString([]byte(domain)),
+		})
+	}
+
+	current, err := client.getCSPPreApprovedDomains(accountID, siteID)
+	if err != nil {
+		log.Printf("[ERROR] Could not get CSP pre-approved domains list for site ID %d: %s\n", siteID, err)
+		return err
+	}
+
+	updates, deletes := getCSPSiteDomainListDeltas(current, desired)
+	log.Printf("[DEBUG] Updating CSP pre-approved domains list for site ID %d: %d to add or update, %d to delete\n", siteID, len(updates), len(deletes))
+
+	err = applyCSPSiteDomainListDeltas(client, accountID, siteID, updates, deletes)
+	if err != nil {
+		log.Printf("[ERROR] %s\n", err)
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%d/%d", accountID, siteID))
+
+	return resourceCSPSiteDomainListRead(d, m)
+}
+
+func resourceCSPSiteDomainListRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+	accountID := d.Get("account_id").(int)
+	siteID := d.Get("site_id").(int)
+
+	log.Printf("[DEBUG] Reading CSP pre-approved domains list for site ID: %d", siteID)
+
+	current, err := client.getCSPPreApprovedDomains(accountID, siteID)
+	if err != nil {
+		log.Printf("[ERROR] Could not get CSP pre-approved domains list for site ID %d: %s\n", siteID, err)
+		return err
+	}
+
+	domains := make([]interface{}, 0, len(current))
+	for _, dom := range current {
+		domains = append(domains, map[string]interface{}{
+			"name":               dom.Domain,
+			"include_subdomains": dom.Subdomains,
+		})
+	}
+	d.Set("domain", domains)
+
+	return nil
+}
+
+func resourceCSPSiteDomainListDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+	accountID := d.Get("account_id").(int)
+	siteID := d.Get("site_id").(int)
+
+	log.Printf("[DEBUG] Deleting CSP pre-approved domains list for site ID %d\n", siteID)
+
+	current, err := client.getCSPPreApprovedDomains(accountID, siteID)
+	if err != nil {
+		log.Printf("[ERROR] Could not get CSP pre-approved domains list for site ID %d: %s\n", siteID, err)
+		return err
+	}
+
+	_, deletes := getCSPSiteDomainListDeltas(current, nil)
+	err = applyCSPSiteDomainListDeltas(client, accountID, siteID, nil, deletes)
+	if err != nil {
+		log.Printf("[ERROR] %s\n", err)
+		return err
+	}
+
+	d.SetId("")
+
+	return nil
+}
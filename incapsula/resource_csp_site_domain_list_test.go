@@ -0,0 +1,154 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const cspDomainListResourceName = "incapsula_csp_site_domain_list"
+const cspDomainListName = "testacc-terraform-csp-domain-list"
+const cspDomainListResource = cspDomainListResourceName + "." + cspDomainListName
+
+func TestAccIncapsulaCSPDomainList_basic(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[DEBUG] Running test resource_csp_site_domain_list_test.TestAccIncapsulaCSPDomainList_basic")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testACCStateCSPDomainListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckCSPDomainListBasic(t),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(cspDomainListResource, "domain.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(cspDomainListResource, "domain.*", map[string]string{
+						"name":               "stam-domain.com",
+						"include_subdomains": "true",
+					}),
+				),
+			},
+			{
+				ResourceName:      cspDomainListResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testACCStateCSPDomainListDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != cspDomainListResourceName {
+			continue
+		}
+
+		accountID, _ := strconv.Atoi(rs.Primary.Attributes["account_id"])
+		siteID, err := strconv.Atoi(rs.Primary.Attributes["site_id"])
+		if err != nil {
+			return fmt.Errorf("failed to convert site ID, actual value : %s, expected numeric id", rs.Primary.Attributes["site_id"])
+		}
+
+		domains, err := client.getCSPPreApprovedDomains(accountID, siteID)
+		if err == nil && len(domains) > 0 {
+			return fmt.Errorf("Resource %s for site ID %d still has %d pre-approved domains", cspDomainListResourceName, siteID, len(domains))
+		}
+	}
+	return nil
+}
+
+func testAccCheckCSPDomainListBasic(t *testing.T) string {
+	return testAccCheckCSPSiteConfigBasic(t) + fmt.Sprintf(`
+	resource "%s" "%s" {
+		account_id	= %s.account_id
+		site_id		= %s.site_id
+		domain {
+			name				= "stam-domain.com"
+			include_subdomains	= true
+		}
+		domain {
+			name	= "cdn.stam-domain.com"
+		}
+		depends_on	= ["%s"]
+	}`,
+		cspDomainListResourceName, cspDomainListName, cspSiteConfigResource, cspSiteConfigResource, cspSiteConfigResource,
+	)
+}
+
+func TestGetCSPSiteDomainListDeltas(t *testing.T) {
+	current := []CSPPreApprovedDomain{
+		{Domain: "keep.com", Subdomains: true, ReferenceID: "a2VlcC5jb20"},
+		{Domain: "change.com", Subdomains: false, ReferenceID: "Y2hhbmdlLmNvbQ"},
+		{Domain: "remove.com", Subdomains: false, ReferenceID: "cmVtb3ZlLmNvbQ"},
+	}
+	desired := []CSPPreApprovedDomain{
+		{Domain: "keep.com", Subdomains: true},
+		{Domain: "change.com", Subdomains: true},
+		{Domain: "add.com", Subdomains: false},
+	}
+
+	updates, deletes := getCSPSiteDomainListDeltas(current, desired)
+	if len(updates) != 2 || updates[0].Domain != "change.com" || updates[1].Domain != "add.com" {
+		t.Errorf("Expected change.com and add.com to be updated, got %v", updates)
+	}
+	if len(deletes) != 1 || deletes[0] != "cmVtb3ZlLmNvbQ" {
+		t.Errorf("Expected remove.com to be deleted, got %v", deletes)
+	}
+}
+
+func TestApplyCSPSiteDomainListDeltas(t *testing.T) {
+	var mutex sync.Mutex
+	requests := map[string]int{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		mutex.Lock()
+		requests[req.Method]++
+		mutex.Unlock()
+
+		switch req.Method {
+		case http.MethodPost:
+			rw.WriteHeader(201)
+			rw.Write([]byte(`{"domain": "domain.com", "subdomains": false, "referenceId": "ZG9tYWluLmNvbQ"}`))
+		case http.MethodDelete:
+			if req.URL.Path == fmt.Sprintf("%s/42/preapprovedlist/ZmFpbC5jb20", CSPSiteApiPath) {
+				rw.WriteHeader(500)
+				return
+			}
+			rw.WriteHeader(204)
+		}
+	}))
+
+	defer server.Close()
+
+	config := &Config{APIID: "foo", APIKey: "bar", BaseURL: server.URL, BaseURLRev2: server.URL, BaseURLAPI: server.URL}
+	client := &Client{config: config, httpClient: &http.Client{}}
+
+	updates := []CSPPreApprovedDomain{}
+	deletes := []string{}
+	for i := 0; i < 25; i++ {
+		updates = append(updates, CSPPreApprovedDomain{Domain: fmt.Sprintf("domain%d.com", i)})
+		deletes = append(deletes, fmt.Sprintf("ref%d", i))
+	}
+
+	err := applyCSPSiteDomainListDeltas(client, 0, 42, updates, deletes)
+	if err != nil {
+		t.Errorf("Should have not received an error, got: %s", err)
+	}
+	if requests[http.MethodPost] != 25 || requests[http.MethodDelete] != 25 {
+		t.Errorf("Expected 25 POST and 25 DELETE requests, got %v", requests)
+	}
+
+	err = applyCSPSiteDomainListDeltas(client, 0, 42, nil, []string{"ZmFpbC5jb20", "ref"})
+	if err == nil {
+		t.Errorf("Should have received an error")
+	}
+}
@@ -1,11 +1,13 @@
 package incapsula
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -14,9 +16,17 @@ func resourceDataCenter() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "This resource is deprecated. It will be removed in a future version. Please use resource incapsula_data_centers_configuration instead.",
 		Create:             resourceDataCenterCreate,
-		Read:               resourceDataCenterRead,
-		Update:             resourceDataCenterUpdate,
-		Delete:             resourceDataCenterDelete,
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			if err := resourceDataCenterRead(d, m); err != nil {
+				return diag.FromErr(err)
+			}
+			if d.Id() == "" {
+				return nil
+			}
+			return getDataCenterModelConflictDiagnostics(m.(*Client), d.Get("site_id").(string), dataCenterModelLegacy)
+		},
+		Update: resourceDataCenterUpdate,
+		Delete: resourceDataCenterDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				idSlice := strings.Split(d.Id(), "/")
@@ -1,10 +1,12 @@
 package incapsula
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -12,9 +14,17 @@ func resourceDataCenterServer() *schema.Resource {
 	return &schema.Resource{
 		DeprecationMessage: "This resource is deprecated. It will be removed in a future version. Please use resource incapsula_data_centers_configuration instead.",
 		Create:             resourceDataCenterServerCreate,
-		Read:               resourceDataCenterServerRead,
-		Update:             resourceDataCenterServerUpdate,
-		Delete:             resourceDataCenterServerDelete,
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			if err := resourceDataCenterServerRead(d, m); err != nil {
+				return diag.FromErr(err)
+			}
+			if d.Id() == "" {
+				return nil
+			}
+			return getDataCenterModelConflictDiagnostics(m.(*Client), d.Get("site_id").(string), dataCenterModelLegacy)
+		},
+		Update: resourceDataCenterServerUpdate,
+		Delete: resourceDataCenterServerDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 				idSlice := strings.Split(d.Id(), "/")
@@ -2,20 +2,33 @@ package incapsula
 
 import (
 	"bytes"
-	"encoding/json"
+	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"hash/crc32"
 	"log"
+	"sort"
 	"strings"
 )
 
 func resourceDataCentersConfiguration() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceDataCentersConfigurationCreate,
-		Read:   resourceDataCentersConfigurationRead,
-		Update: resourceDataCentersConfigurationCreate,
+		ReadContext: func(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+			previous := d.Get("data_center").([]interface{})
+			if err := resourceDataCentersConfigurationRead(d, m); err != nil {
+				return diag.FromErr(err)
+			}
+			if d.Id() == "" {
+				return nil
+			}
+			siteID := d.Get("site_id").(string)
+			diags := getUnmanagedDataCenterDiagnostics(siteID, previous, d.Get("data_center").([]interface{}))
+			return append(diags, getDataCenterModelConflictDiagnostics(m.(*Client), siteID, dataCenterModelConfiguration)...)
+		},
+		Update: resourceDataCentersConfigurationUpdate,
 		Delete: resourceDataCentersConfigurationDelete,
 		Importer: &schema.ResourceImporter{
 			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
@@ -24,6 +37,24 @@ func resourceDataCentersConfiguration() *schema.Resource {
 			},
 		},
 
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceDataCentersConfigurationV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceDataCentersConfigurationStateUpgradeV0,
+			},
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			if err := validateDataCentersConfigurationDiff(d); err != nil {
+				return err
+			}
+			if err := validateDataCentersConfigurationOriginPops(d, m); err != nil {
+				return err
+			}
+			return customizeDataCentersConfigurationIDsDiff(d)
+		},
+
 		Schema: map[string]*schema.Schema{
 			// Required Arguments
 			"site_id": {
@@ -81,11 +112,10 @@ func resourceDataCentersConfiguration() *schema.Resource {
 				Default:     true,
 			},
 			"data_center": {
-				Description: "A set of Data Centers and their Origin Servers",
+				Description: "A list of Data Centers and their Origin Servers, identified by their unique name",
 				Required:    true,
 				MinItems:    1,
-				Type:        schema.TypeSet,
-				Set:         resourceDataCentersConfigurationDataCenterHash,
+				Type:        schema.TypeList,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"name": {
@@ -164,7 +194,7 @@ func resourceDataCentersConfiguration() *schema.Resource {
 							Default:     "",
 							ValidateFunc: func(val interface{}, key string) (warns []string, errs []error) {
 								strVals := strings.Split(val.(string), ",")
-								allowedVals := []string{"EUROPE", "AUSTRALIA", "US_EAST", "US_WEST", "AFRICA", "ASIA", "SOUTH_AMERICA", "NORTH_AMERICA"}
+								allowedVals := dataCenterGeoLocations
 								for _, strVal := range strVals {
 									if strVal != "" && !isValidEnum(strVal, key, allowedVals) {
 										errs = append(errs, fmt.Errorf("%q must be an empty string or any of: [%s]. Got: %s",
@@ -222,10 +252,286 @@ func resourceDataCentersConfiguration() *schema.Resource {
 					},
 				},
 			},
+			"adopt_existing": {
+				Description: "When true, the resource is created by adopting the existing Data Centers configuration of the site without changing it, e.g. when moving from the incapsula_data_center and incapsula_data_center_server resources. The creation fails when the configuration doesn't match the existing one.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				// Only used on creation
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Id() != ""
+				},
+			},
+			"data_center_ids": {
+				Description: "The ids of the Data Centers by name, e.g. to be used as dc_id of a RULE_ACTION_FORWARD_TO_DC incap rule",
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
 		},
 	}
 }
 
+// validateDataCentersConfigurationDiff fails the plan when the data centers don't match the site topology and load balancing
+func validateDataCentersConfigurationDiff(d *schema.ResourceDiff) error {
+	for _, key := range []string{"site_topology", "site_lb_algorithm", "data_center"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+	// Weights or flags unknown until apply
+	if rawConfig := d.GetRawConfig(); !rawConfig.IsNull() && !rawConfig.GetAttr("data_center").IsWhollyKnown() {
+		return nil
+	}
+
+	return validateDataCentersConfiguration(d.Get("site_topology").(string), d.Get("site_lb_algorithm").(string), d.Get("data_center").([]interface{}))
+}
+
+// validateDataCentersConfigurationOriginPops fails the plan when an origin_pop is not a PoP supporting origin PoP.
+// The PoPs are only fetched when the data centers change, and the validation is skipped when they can't be fetched.
+func validateDataCentersConfigurationOriginPops(d *schema.ResourceDiff, m interface{}) error {
+	if !d.HasChange("data_center") || !d.NewValueKnown("data_center") {
+		return nil
+	}
+	if rawConfig := d.GetRawConfig(); !rawConfig.IsNull() && !rawConfig.GetAttr("data_center").IsWhollyKnown() {
+		return nil
+	}
+
+	originPops := map[string]string{}
+	for _, dataCenter := range d.Get("data_center").([]interface{}) {
+		dc := dataCenter.(map[string]interface{})
+		if originPop := dc["origin_pop"].(string); originPop != "" {
+			originPops[dc["name"].(string)] = originPop
+		}
+	}
+	if len(originPops) == 0 {
+		return nil
+	}
+
+	popsDTO, err := m.(*Client).GetPops()
+	if err != nil {
+		log.Printf("[WARN] Could not validate the origin_pop of the data centers: %s\n", err)
+		return nil
+	}
+
+	names := make([]string, 0, len(originPops))
+	for name := range originPops {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := validateOriginPop(popsDTO.Data, originPops[name]); err != nil {
+			return fmt.Errorf("data_center[%q].origin_pop: %s", name, err)
+		}
+	}
+
+	return nil
+}
+
+// validateDataCentersConfiguration checks the invariants enforced by the API, errors refer to the data centers by name
+// and to the origin servers by address, e.g. data_center["dc1"].origin_server["1.2.3.4"].weight
+func validateDataCentersConfiguration(siteTopology, siteLbAlgorithm string, dataCenters []interface{}) error {
+	dcs := make([]map[string]interface{}, 0, len(dataCenters))
+	for _, dataCenter := range dataCenters {
+		dcs = append(dcs, dataCenter.(map[string]interface{}))
+	}
+	sort.Slice(dcs, func(i, j int) bool { return dcs[i]["name"].(string) < dcs[j]["name"].(string) })
+
+	switch siteTopology {
+	case "SINGLE_SERVER":
+		if len(dcs) != 1 || dcs[0]["origin_server"].(*schema.Set).Len() != 1 {
+			return fmt.Errorf("site_topology SINGLE_SERVER requires exactly one data_center with exactly one origin_server")
+		}
+	case "SINGLE_DC":
+		if len(dcs) != 1 {
+			return fmt.Errorf("site_topology SINGLE_DC requires exactly one data_center, got %d", len(dcs))
+		}
+	}
+
+	for i := 1; i < len(dcs); i++ {
+		if dcs[i]["name"].(string) == dcs[i-1]["name"].(string) {
+			return fmt.Errorf("data_center names must be unique, got %q more than once", dcs[i]["name"].(string))
+		}
+	}
+
+	isGeo := siteLbAlgorithm == "GEO_PREFERRED" || siteLbAlgorithm == "GEO_REQUIRED"
+	dcWeights := 0
+	restOfTheWorldDCs := []string{}
+	standbyDCs := []string{}
+	for _, dc := range dcs {
+		path := fmt.Sprintf("data_center[%q]", dc["name"].(string))
+
+		if dc["ip_mode"].(string) != "SINGLE_IP" && dc["web_servers_per_server"].(int) != 1 {
+			return fmt.Errorf("%s.web_servers_per_server is only supported with ip_mode SINGLE_IP", path)
+		}
+		if dc["web_servers_per_server"].(int) < 1 {
+			return fmt.Errorf("%s.web_servers_per_server must be at least 1, got %d", path, dc["web_servers_per_server"].(int))
+		}
+
+		weight := dc["weight"].(int)
+		if weight < 0 || weight > 100 {
+			return fmt.Errorf("%s.weight must be between 0 and 100, got %d", path, weight)
+		}
+		dcWeights += weight
+
+		if dc["is_rest_of_the_world"].(bool) {
+			restOfTheWorldDCs = append(restOfTheWorldDCs, path)
+		}
+		if !dc["is_active"].(bool) {
+			standbyDCs = append(standbyDCs, path)
+		}
+		if isGeo && !dc["is_rest_of_the_world"].(bool) && !dc["is_content"].(bool) && dc["geo_locations"].(string) == "" {
+			return fmt.Errorf("%s.geo_locations is required with site_lb_algorithm %s, unless is_rest_of_the_world or is_content is true", path, siteLbAlgorithm)
+		}
+
+		if err := validateDataCenterOriginServers(path, dc); err != nil {
+			return err
+		}
+	}
+
+	if siteLbAlgorithm == "WEIGHTED_LB" && dcWeights != 100 {
+		return fmt.Errorf("the weight of all the data_center must sum to 100 with site_lb_algorithm WEIGHTED_LB, got %d", dcWeights)
+	}
+	if len(restOfTheWorldDCs) > 1 {
+		return fmt.Errorf("only one data_center can have is_rest_of_the_world true, got: %s", strings.Join(restOfTheWorldDCs, ", "))
+	}
+	if isGeo && len(restOfTheWorldDCs) == 0 {
+		return fmt.Errorf("one data_center must have is_rest_of_the_world true with site_lb_algorithm %s", siteLbAlgorithm)
+	}
+	if len(standbyDCs) > 1 {
+		return fmt.Errorf("only one data_center can be a standby data center (is_active false), got: %s", strings.Join(standbyDCs, ", "))
+	}
+
+	return nil
+}
+
+func validateDataCenterOriginServers(path string, dc map[string]interface{}) error {
+	servers := make([]map[string]interface{}, 0)
+	for _, originServer := range dc["origin_server"].(*schema.Set).List() {
+		servers = append(servers, originServer.(map[string]interface{}))
+	}
+	sort.Slice(servers, func(i, j int) bool { return servers[i]["address"].(string) < servers[j]["address"].(string) })
+
+	serverWeights := 0
+	hasActiveServer := false
+	for _, server := range servers {
+		serverPath := fmt.Sprintf("%s.origin_server[%q]", path, server["address"].(string))
+		weight := server["weight"].(int)
+		if weight < 0 || weight > 100 {
+			return fmt.Errorf("%s.weight must be between 0 and 100, got %d", serverPath, weight)
+		}
+		serverWeights += weight
+		if server["is_enabled"].(bool) && server["is_active"].(bool) {
+			hasActiveServer = true
+		}
+	}
+
+	if dc["dc_lb_algorithm"].(string) == "WEIGHTED" && serverWeights != 100 {
+		return fmt.Errorf("the weight of all the %s.origin_server must sum to 100 with dc_lb_algorithm WEIGHTED, got %d", path, serverWeights)
+	}
+	if !hasActiveServer {
+		return fmt.Errorf("%s requires at least one enabled origin_server with is_active true", path)
+	}
+
+	return nil
+}
+
+// customizeDataCentersConfigurationIDsDiff shows the data_center_ids in the plan when all the data centers already exist
+func customizeDataCentersConfigurationIDsDiff(d *schema.ResourceDiff) error {
+	if !d.HasChange("data_center") {
+		return nil
+	}
+	if !d.NewValueKnown("data_center") {
+		return d.SetNewComputed("data_center_ids")
+	}
+
+	oldDataCenters, newDataCenters := d.GetChange("data_center")
+	ids := getDataCenterIDsByName(oldDataCenters.([]interface{}), newDataCenters.([]interface{}))
+	if len(ids) != len(newDataCenters.([]interface{})) {
+		return d.SetNewComputed("data_center_ids")
+	}
+
+	return d.SetNew("data_center_ids", ids)
+}
+
+// getDataCenterIDsByName maps the names of the new data centers to the dc_id of the old ones.
+// A data center keeps its id when its name is unchanged, or when it is renamed at the same position of the list.
+func getDataCenterIDsByName(oldDataCenters, newDataCenters []interface{}) map[string]int {
+	oldIDs := map[string]int{}
+	for _, dataCenter := range oldDataCenters {
+		dc := dataCenter.(map[string]interface{})
+		if id, _ := dc["dc_id"].(int); id > 0 {
+			oldIDs[dc["name"].(string)] = id
+		}
+	}
+
+	newNames := map[string]bool{}
+	for _, dataCenter := range newDataCenters {
+		if dc, ok := dataCenter.(map[string]interface{}); ok {
+			newNames[dc["name"].(string)] = true
+		}
+	}
+
+	ids := map[string]int{}
+	for i, dataCenter := range newDataCenters {
+		dc, ok := dataCenter.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		name := dc["name"].(string)
+		if id, ok := oldIDs[name]; ok {
+			ids[name] = id
+			continue
+		}
+
+		// Renamed
+		if i < len(oldDataCenters) {
+			oldDC := oldDataCenters[i].(map[string]interface{})
+			if id, _ := oldDC["dc_id"].(int); id > 0 && !newNames[oldDC["name"].(string)] {
+				ids[name] = id
+			}
+		}
+	}
+
+	return ids
+}
+
+// sortDataCentersLikeState orders the data centers read from the API like the data centers of the state, matched by
+// id and then by name, so the list doesn't show changes when the API returns them in another order.
+// Data centers which are not in the state are added at the end, sorted by name.
+func sortDataCentersLikeState(dataCenters []DataCenterStruct, stateDataCenters []interface{}) []DataCenterStruct {
+	positions := map[string]int{}
+	for i, dataCenter := range stateDataCenters {
+		dc := dataCenter.(map[string]interface{})
+		if id, _ := dc["dc_id"].(int); id > 0 {
+			positions[fmt.Sprintf("id:%d", id)] = i
+		}
+		positions["name:"+dc["name"].(string)] = i
+	}
+
+	position := func(dc DataCenterStruct) int {
+		if dc.ID != nil {
+			if i, ok := positions[fmt.Sprintf("id:%d", *dc.ID)]; ok {
+				return i
+			}
+		}
+		if i, ok := positions["name:"+dc.Name]; ok {
+			return i
+		}
+		return len(stateDataCenters)
+	}
+
+	sorted := append([]DataCenterStruct{}, dataCenters...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		pi, pj := position(sorted[i]), position(sorted[j])
+		if pi != pj {
+			return pi < pj
+		}
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	return sorted
+}
+
 func isValidEnum(val string, key string, allowedValues []string) bool {
 	for _, allowedVal := range allowedValues {
 		if allowedVal == val {
@@ -267,18 +573,26 @@ func populateFromConfOriginServers(dc map[string]interface{}) []OriginServerStru
 	return originServerStructs
 }
 
-func populateFromConfDataCenters(d *schema.ResourceData) []DataCenterStruct {
-	dataCentersConf := d.Get("data_center").(*schema.Set)
-	var dataCentersStructs = make([]DataCenterStruct, len(dataCentersConf.List()))
+// populateFromConfDataCenters builds the data centers to put, a data center which is not in the state keeps the id of the
+// existing data center of the site with the same name
+func populateFromConfDataCenters(d *schema.ResourceData, existingIDs map[string]int) []DataCenterStruct {
+	oldDataCenters, newDataCenters := d.GetChange("data_center")
+	dataCenterIDs := getDataCenterIDsByName(oldDataCenters.([]interface{}), newDataCenters.([]interface{}))
+	for name, id := range existingIDs {
+		if _, ok := dataCenterIDs[name]; !ok {
+			dataCenterIDs[name] = id
+		}
+	}
+	dataCentersConf := newDataCenters.([]interface{})
+	var dataCentersStructs = make([]DataCenterStruct, len(dataCentersConf))
 	var dcInd int = 0
-	for _, dataCenter := range dataCentersConf.List() {
+	for _, dataCenter := range dataCentersConf {
 		dc := dataCenter.(map[string]interface{})
 		dataCentersStructs[dcInd] = DataCenterStruct{}
 		if attr, ok := dc["name"]; ok && attr != "" {
 			dataCentersStructs[dcInd].Name = attr.(string)
 		}
-		if attr, ok := dc["dc_id"]; ok && attr != "" && (attr.(int) > 0) {
-			dcId := attr.(int)
+		if dcId, ok := dataCenterIDs[dataCentersStructs[dcInd].Name]; ok {
 			dataCentersStructs[dcInd].ID = &dcId
 		}
 		if attr, ok := dc["ip_mode"]; ok && attr != "" {
@@ -322,7 +636,7 @@ func populateFromConfDataCenters(d *schema.ResourceData) []DataCenterStruct {
 	return dataCentersStructs
 }
 
-func populateFromConfDataCentersConfigurationDTO(d *schema.ResourceData) DataCentersConfigurationDTO {
+func populateFromConfDataCentersConfigurationDTO(d *schema.ResourceData, existingIDs map[string]int) DataCentersConfigurationDTO {
 	requestDTO := DataCentersConfigurationDTO{}
 	requestDTO.Data = make([]DataCentersStruct, 1)
 	requestDTO.Data[0].DataCenterMode = d.Get("site_topology").(string)
@@ -333,15 +647,60 @@ func populateFromConfDataCentersConfigurationDTO(d *schema.ResourceData) DataCen
 	requestDTO.Data[0].KickStartPass = d.Get("kickstart_password").(string)
 	requestDTO.Data[0].MinAvailableServersForDataCenterUp = d.Get("min_available_servers_for_dc_up").(int)
 	requestDTO.Data[0].SiteLbAlgorithm = d.Get("site_lb_algorithm").(string)
-	requestDTO.Data[0].DataCenters = populateFromConfDataCenters(d)
+	requestDTO.Data[0].DataCenters = populateFromConfDataCenters(d, existingIDs)
 
 	return requestDTO
 }
 
+// resourceDataCentersConfigurationCreate matches the configured data centers with the existing data centers of the site by
+// name, so that data centers managed until now by the legacy resources keep their id. With adopt_existing, the existing
+// configuration is not changed and must match the configured one.
 func resourceDataCentersConfigurationCreate(d *schema.ResourceData, m interface{}) error {
 	client := m.(*Client)
+	siteID := d.Get("site_id").(string)
+
+	existingDTO, err := client.GetDataCentersConfiguration(siteID)
+	if err != nil {
+		return fmt.Errorf("Error getting Data Centers configuration for site (%s): %s", siteID, err)
+	}
+	if len(existingDTO.Errors) > 0 || len(existingDTO.Data) == 0 {
+		return fmt.Errorf("Error getting Data Centers configuration for site (%s): %s", siteID, formatApiErrors(existingDTO.Errors))
+	}
+	existing := existingDTO.Data[0]
+
+	requestDTO := populateFromConfDataCentersConfigurationDTO(d, getExistingDataCenterIDs(existing.DataCenters))
+
+	if d.Get("adopt_existing").(bool) {
+		if differences := diffDataCentersConfiguration(existing, requestDTO.Data[0]); len(differences) > 0 {
+			return fmt.Errorf("Error adopting Data Centers configuration for site (%s), the configuration doesn't match the existing one:\n%s",
+				siteID, strings.Join(differences, "\n"))
+		}
+
+		log.Printf("[INFO] Adopted the existing Data Centers configuration of site (%s)\n", siteID)
+		d.SetId(siteID)
+		return resourceDataCentersConfigurationRead(d, m)
+	}
+
+	requested := map[string]bool{}
+	for _, dc := range requestDTO.Data[0].DataCenters {
+		requested[dc.Name] = true
+	}
+	for _, dc := range existing.DataCenters {
+		if !requested[dc.Name] {
+			log.Printf("[WARN] Data Center (%s) of site (%s) is not in the configuration and will be deleted\n", dc.Name, siteID)
+		}
+	}
+
+	return putDataCentersConfiguration(d, m, requestDTO)
+}
+
+func resourceDataCentersConfigurationUpdate(d *schema.ResourceData, m interface{}) error {
+	return putDataCentersConfiguration(d, m, populateFromConfDataCentersConfigurationDTO(d, nil))
+}
+
+func putDataCentersConfiguration(d *schema.ResourceData, m interface{}, requestDTO DataCentersConfigurationDTO) error {
+	client := m.(*Client)
 
-	requestDTO := populateFromConfDataCentersConfigurationDTO(d)
 	responseDTO, err := client.PutDataCentersConfiguration(d.Get("site_id").(string), requestDTO)
 	if err != nil {
 		return fmt.Errorf("Error updating Data Centers configuration for site (%s): %s",
@@ -350,7 +709,7 @@ func resourceDataCentersConfigurationCreate(d *schema.ResourceData, m interface{
 
 	if responseDTO.Errors != nil && len(responseDTO.Errors) > 0 {
 		return fmt.Errorf("Error updating Data Centers configuration for site (%s): %s",
-			d.Get("site_id"), responseDTO.Errors)
+			d.Get("site_id"), formatApiErrors(responseDTO.Errors))
 	}
 
 	// Set the dc ID
@@ -375,11 +734,7 @@ func resourceDataCentersConfigurationRead(d *schema.ResourceData, m interface{})
 			return nil
 		}
 
-		out, err := json.Marshal(responseDTO.Errors)
-		if err != nil {
-			panic(err)
-		}
-		return fmt.Errorf("Error getting Data Centers configuration for site (%s): %s", d.Get("site_id"), string(out))
+		return fmt.Errorf("Error getting Data Centers configuration for site (%s): %s", d.Get("site_id"), formatApiErrors(responseDTO.Errors))
 	}
 
 	d.Set("site_lb_algorithm", responseDTO.Data[0].SiteLbAlgorithm)
@@ -391,12 +746,14 @@ func resourceDataCentersConfigurationRead(d *schema.ResourceData, m interface{})
 	d.Set("kickstart_password", responseDTO.Data[0].KickStartPass)
 	d.Set("is_persistent", responseDTO.Data[0].IsPersistent)
 
-	dataCenters := &schema.Set{F: resourceDataCentersConfigurationDataCenterHash}
-	for _, v := range responseDTO.Data[0].DataCenters {
+	dataCenters := make([]interface{}, 0, len(responseDTO.Data[0].DataCenters))
+	dataCenterIDs := map[string]int{}
+	for _, v := range sortDataCentersLikeState(responseDTO.Data[0].DataCenters, d.Get("data_center").([]interface{})) {
 		dataCenter := map[string]interface{}{}
 		dataCenter["name"] = v.Name
 		if v.ID != nil {
 			dataCenter["dc_id"] = *v.ID
+			dataCenterIDs[v.Name] = *v.ID
 		} else {
 			dataCenter["dc_id"] = 0
 		}
@@ -433,10 +790,11 @@ func resourceDataCentersConfigurationRead(d *schema.ResourceData, m interface{})
 			originServers.Add(originServer)
 		}
 		dataCenter["origin_server"] = originServers
-		dataCenters.Add(dataCenter)
+		dataCenters = append(dataCenters, dataCenter)
 	}
 
 	d.Set("data_center", dataCenters)
+	d.Set("data_center_ids", dataCenterIDs)
 
 	return nil
 }
@@ -450,11 +808,7 @@ func resourceDataCentersConfigurationDelete(d *schema.ResourceData, m interface{
 	}
 
 	if responseDTO.Errors != nil && len(responseDTO.Errors) > 0 && responseDTO.Errors[0].Status != "404" {
-		out, err := json.Marshal(responseDTO.Errors)
-		if err != nil {
-			panic(err)
-		}
-		return fmt.Errorf("Error deleting Data Centers configuration for site (%s): %s", d.Get("site_id"), string(out))
+		return fmt.Errorf("Error deleting Data Centers configuration for site (%s): %s", d.Get("site_id"), formatApiErrors(responseDTO.Errors))
 	}
 
 	d.SetId("")
@@ -484,77 +838,6 @@ func resourceDataCentersConfigurationOriginServerHash(v interface{}) int {
 	return PositiveHash(buf.String())
 }
 
-func resourceDataCentersConfigurationDataCenterHash(v interface{}) int {
-	var buf bytes.Buffer
-	m := v.(map[string]interface{})
-
-	if v, ok := m["name"]; ok {
-		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
-	}
-
-	if v, ok := m["ip_mode"]; ok {
-		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
-	}
-
-	if v, ok := m["web_servers_per_server"]; ok {
-		buf.WriteString(fmt.Sprintf("%d-", v.(int)))
-	}
-
-	if v, ok := m["dc_lb_algorithm"]; ok {
-		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
-	}
-
-	if v, ok := m["weight"]; ok {
-		buf.WriteString(fmt.Sprintf("%d-", v.(int)))
-	}
-
-	if v, ok := m["is_enabled"]; ok {
-		buf.WriteString(fmt.Sprintf("%t-", v.(bool)))
-	}
-
-	if v, ok := m["is_active"]; ok {
-		buf.WriteString(fmt.Sprintf("%t-", v.(bool)))
-	}
-
-	if v, ok := m["is_content"]; ok {
-		buf.WriteString(fmt.Sprintf("%t-", v.(bool)))
-	}
-
-	if v, ok := m["is_rest_of_the_world"]; ok {
-		buf.WriteString(fmt.Sprintf("%t-", v.(bool)))
-	}
-
-	if v, ok := m["geo_locations"]; ok {
-		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
-	}
-
-	if v, ok := m["origin_pop"]; ok {
-		buf.WriteString(fmt.Sprintf("%s-", v.(string)))
-	}
-
-	originServerConf := m["origin_server"].(*schema.Set)
-	for _, originServer := range originServerConf.List() {
-		os := originServer.(map[string]interface{})
-		if v, ok := os["address"]; ok {
-			buf.WriteString(fmt.Sprintf("%s-", v.(string)))
-		}
-
-		if v, ok := os["weight"]; ok {
-			buf.WriteString(fmt.Sprintf("%d-", v.(int)))
-		}
-
-		if v, ok := os["is_enabled"]; ok {
-			buf.WriteString(fmt.Sprintf("%t-", v.(bool)))
-		}
-
-		if v, ok := os["is_active"]; ok {
-			buf.WriteString(fmt.Sprintf("%t-", v.(bool)))
-		}
-	}
-
-	return PositiveHash(buf.String())
-}
-
 func PositiveHash(s string) int {
 	v := int(crc32.ChecksumIEEE([]byte(s)))
 	if v >= 0 {
@@ -566,3 +849,66 @@ func PositiveHash(s string) int {
 	// v == MinInt
 	return 0
 }
+
+func resourceDataCentersConfigurationV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"site_id":                         {Type: schema.TypeString, Required: true},
+			"site_lb_algorithm":               {Type: schema.TypeString, Optional: true},
+			"fail_over_required_monitors":     {Type: schema.TypeString, Optional: true},
+			"site_topology":                   {Type: schema.TypeString, Optional: true},
+			"min_available_servers_for_dc_up": {Type: schema.TypeInt, Optional: true},
+			"kickstart_url":                   {Type: schema.TypeString, Optional: true},
+			"kickstart_user":                  {Type: schema.TypeString, Optional: true},
+			"kickstart_password":              {Type: schema.TypeString, Optional: true},
+			"is_persistent":                   {Type: schema.TypeBool, Optional: true},
+			"data_center": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name":                   {Type: schema.TypeString, Required: true},
+						"dc_id":                  {Type: schema.TypeInt, Computed: true},
+						"ip_mode":                {Type: schema.TypeString, Optional: true},
+						"web_servers_per_server": {Type: schema.TypeInt, Optional: true},
+						"dc_lb_algorithm":        {Type: schema.TypeString, Optional: true},
+						"weight":                 {Type: schema.TypeInt, Optional: true},
+						"is_enabled":             {Type: schema.TypeBool, Optional: true},
+						"is_active":              {Type: schema.TypeBool, Optional: true},
+						"is_content":             {Type: schema.TypeBool, Optional: true},
+						"is_rest_of_the_world":   {Type: schema.TypeBool, Optional: true},
+						"geo_locations":          {Type: schema.TypeString, Optional: true},
+						"origin_pop":             {Type: schema.TypeString, Optional: true},
+						"origin_server": {
+							Type:     schema.TypeSet,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"address":    {Type: schema.TypeString, Required: true},
+									"weight":     {Type: schema.TypeInt, Optional: true},
+									"is_enabled": {Type: schema.TypeBool, Optional: true},
+									"is_active":  {Type: schema.TypeBool, Optional: true},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// resourceDataCentersConfigurationStateUpgradeV0 orders the data centers of the former set by name, so the first plan
+// of the list only shows changes when the configuration lists the data centers in another order
+func resourceDataCentersConfigurationStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	dataCenters, _ := rawState["data_center"].([]interface{})
+	sorted := append([]interface{}{}, dataCenters...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		nameI, _ := sorted[i].(map[string]interface{})["name"].(string)
+		nameJ, _ := sorted[j].(map[string]interface{})["name"].(string)
+		return nameI < nameJ
+	})
+	rawState["data_center"] = sorted
+
+	return rawState, nil
+}
@@ -1,12 +1,14 @@
 package incapsula
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strconv"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
@@ -158,3 +160,187 @@ resource "%s" "%s" {
 }`, dataCentersConfigurationResource, dataCentersConfigurationName, siteResourceName, dataCenterName, siteResourceName,
 	)
 }
+
+func TestValidateDataCentersConfiguration(t *testing.T) {
+	dataCenter := func(name string, fields map[string]interface{}, servers ...map[string]interface{}) map[string]interface{} {
+		dc := map[string]interface{}{"name": name}
+		for key, value := range fields {
+			dc[key] = value
+		}
+		originServers := []interface{}{}
+		for _, server := range servers {
+			originServers = append(originServers, server)
+		}
+		dc["origin_server"] = originServers
+		return dc
+	}
+	server := func(address string, fields map[string]interface{}) map[string]interface{} {
+		originServer := map[string]interface{}{"address": address}
+		for key, value := range fields {
+			originServer[key] = value
+		}
+		return originServer
+	}
+
+	cases := []struct {
+		name        string
+		topology    string
+		lbAlgorithm string
+		dataCenters []interface{}
+		expectedErr string
+	}{
+		{
+			name: "single dc", topology: "SINGLE_DC", lbAlgorithm: "BEST_CONNECTION_TIME",
+			dataCenters: []interface{}{dataCenter("dc1", nil, server("1.1.1.1", nil), server("1.1.1.2", map[string]interface{}{"is_active": false}))},
+		},
+		{
+			name: "single server with two servers", topology: "SINGLE_SERVER", lbAlgorithm: "BEST_CONNECTION_TIME",
+			dataCenters: []interface{}{dataCenter("dc1", nil, server("1.1.1.1", nil), server("1.1.1.2", nil))},
+			expectedErr: "site_topology SINGLE_SERVER requires exactly one data_center with exactly one origin_server",
+		},
+		{
+			name: "single dc with two dcs", topology: "SINGLE_DC", lbAlgorithm: "BEST_CONNECTION_TIME",
+			dataCenters: []interface{}{dataCenter("dc1", nil, server("1.1.1.1", nil)), dataCenter("dc2", nil, server("2.2.2.2", nil))},
+			expectedErr: "site_topology SINGLE_DC requires exactly one data_center, got 2",
+		},
+		{
+			name: "weighted lb", topology: "MULTIPLE_DC", lbAlgorithm: "WEIGHTED_LB",
+			dataCenters: []interface{}{
+				dataCenter("dc1", map[string]interface{}{"weight": 60}, server("1.1.1.1", nil)),
+				dataCenter("dc2", map[string]interface{}{"weight": 40}, server("2.2.2.2", nil)),
+			},
+		},
+		{
+			name: "weighted lb not summing to 100", topology: "MULTIPLE_DC", lbAlgorithm: "WEIGHTED_LB",
+			dataCenters: []interface{}{
+				dataCenter("dc1", map[string]interface{}{"weight": 60}, server("1.1.1.1", nil)),
+				dataCenter("dc2", map[string]interface{}{"weight": 30}, server("2.2.2.2", nil)),
+			},
+			expectedErr: "the weight of all the data_center must sum to 100 with site_lb_algorithm WEIGHTED_LB, got 90",
+		},
+		{
+			name: "weighted servers not summing to 100", topology: "SINGLE_DC", lbAlgorithm: "BEST_CONNECTION_TIME",
+			dataCenters: []interface{}{dataCenter("dc1", map[string]interface{}{"dc_lb_algorithm": "WEIGHTED"},
+				server("1.1.1.1", map[string]interface{}{"weight": 50}), server("1.1.1.2", map[string]interface{}{"weight": 20}))},
+			expectedErr: `the weight of all the data_center["dc1"].origin_server must sum to 100 with dc_lb_algorithm WEIGHTED, got 70`,
+		},
+		{
+			name: "server weight out of range", topology: "SINGLE_DC", lbAlgorithm: "BEST_CONNECTION_TIME",
+			dataCenters: []interface{}{dataCenter("dc1", nil, server("1.1.1.1", map[string]interface{}{"weight": 101}))},
+			expectedErr: `data_center["dc1"].origin_server["1.1.1.1"].weight must be between 0 and 100, got 101`,
+		},
+		{
+			name: "web servers per server with multiple ip", topology: "SINGLE_DC", lbAlgorithm: "BEST_CONNECTION_TIME",
+			dataCenters: []interface{}{dataCenter("dc1", map[string]interface{}{"web_servers_per_server": 5}, server("1.1.1.1", nil))},
+			expectedErr: `data_center["dc1"].web_servers_per_server is only supported with ip_mode SINGLE_IP`,
+		},
+		{
+			name: "two rest of the world dcs", topology: "MULTIPLE_DC", lbAlgorithm: "BEST_CONNECTION_TIME",
+			dataCenters: []interface{}{
+				dataCenter("dc1", map[string]interface{}{"is_rest_of_the_world": true}, server("1.1.1.1", nil)),
+				dataCenter("dc2", map[string]interface{}{"is_rest_of_the_world": true}, server("2.2.2.2", nil)),
+			},
+			expectedErr: `only one data_center can have is_rest_of_the_world true, got: data_center["dc1"], data_center["dc2"]`,
+		},
+		{
+			name: "geo without geo locations", topology: "MULTIPLE_DC", lbAlgorithm: "GEO_PREFERRED",
+			dataCenters: []interface{}{
+				dataCenter("dc1", map[string]interface{}{"is_rest_of_the_world": true}, server("1.1.1.1", nil)),
+				dataCenter("dc2", nil, server("2.2.2.2", nil)),
+			},
+			expectedErr: `data_center["dc2"].geo_locations is required with site_lb_algorithm GEO_PREFERRED, unless is_rest_of_the_world or is_content is true`,
+		},
+		{
+			name: "geo without rest of the world", topology: "MULTIPLE_DC", lbAlgorithm: "GEO_REQUIRED",
+			dataCenters: []interface{}{dataCenter("dc1", map[string]interface{}{"geo_locations": "EUROPE"}, server("1.1.1.1", nil))},
+			expectedErr: "one data_center must have is_rest_of_the_world true with site_lb_algorithm GEO_REQUIRED",
+		},
+		{
+			name: "only standby servers", topology: "SINGLE_DC", lbAlgorithm: "BEST_CONNECTION_TIME",
+			dataCenters: []interface{}{dataCenter("dc1", nil, server("1.1.1.1", map[string]interface{}{"is_active": false}))},
+			expectedErr: `data_center["dc1"] requires at least one enabled origin_server with is_active true`,
+		},
+	}
+
+	for _, c := range cases {
+		d := schema.TestResourceDataRaw(t, resourceDataCentersConfiguration().Schema, map[string]interface{}{
+			"site_id":           "42",
+			"site_topology":     c.topology,
+			"site_lb_algorithm": c.lbAlgorithm,
+			"data_center":       c.dataCenters,
+		})
+		err := validateDataCentersConfiguration(c.topology, c.lbAlgorithm, d.Get("data_center").([]interface{}))
+		if c.expectedErr == "" && err != nil {
+			t.Errorf("%s: should not have received an error, got: %s", c.name, err)
+		}
+		if c.expectedErr != "" && (err == nil || err.Error() != c.expectedErr) {
+			t.Errorf("%s: expected error %q, got: %v", c.name, c.expectedErr, err)
+		}
+	}
+}
+
+func TestGetDataCenterIDsByName(t *testing.T) {
+	dc := func(name string, id int) interface{} {
+		return map[string]interface{}{"name": name, "dc_id": id}
+	}
+	oldDataCenters := []interface{}{dc("main", 1), dc("backup", 2)}
+
+	cases := []struct {
+		name           string
+		newDataCenters []interface{}
+		expected       string
+	}{
+		{"unchanged", []interface{}{dc("main", 0), dc("backup", 0)}, "map[backup:2 main:1]"},
+		{"reordered", []interface{}{dc("backup", 0), dc("main", 0)}, "map[backup:2 main:1]"},
+		{"renamed", []interface{}{dc("main", 0), dc("standby", 0)}, "map[main:1 standby:2]"},
+		{"added", []interface{}{dc("new", 0), dc("main", 0), dc("backup", 0)}, "map[backup:2 main:1]"},
+		{"removed", []interface{}{dc("backup", 0)}, "map[backup:2]"},
+		{"renamed at the same position", []interface{}{dc("primary", 0), dc("backup", 0)}, "map[backup:2 primary:1]"},
+		{"removed one and added one at its position", []interface{}{dc("dr", 0), dc("backup", 0)}, "map[backup:2 dr:1]"},
+		{"removed one and added one at another position", []interface{}{dc("backup", 0), dc("dr", 0)}, "map[backup:2]"},
+		{"renamed both", []interface{}{dc("a", 0), dc("b", 0)}, "map[a:1 b:2]"},
+	}
+
+	for _, c := range cases {
+		ids := getDataCenterIDsByName(oldDataCenters, c.newDataCenters)
+		if fmt.Sprint(ids) != c.expected {
+			t.Errorf("%s: expected %s, got: %v", c.name, c.expected, ids)
+		}
+	}
+}
+
+func TestResourceDataCentersConfigurationStateUpgradeV0(t *testing.T) {
+	rawState := map[string]interface{}{
+		"site_id": "1234",
+		"data_center": []interface{}{
+			map[string]interface{}{"name": "main", "dc_id": 1},
+			map[string]interface{}{"name": "backup", "dc_id": 2},
+		},
+	}
+
+	actual, err := resourceDataCentersConfigurationStateUpgradeV0(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+	expected := "[map[dc_id:2 name:backup] map[dc_id:1 name:main]]"
+	if fmt.Sprint(actual["data_center"]) != expected {
+		t.Errorf("Expected the data centers sorted by name %s, got: %v", expected, actual["data_center"])
+	}
+}
+
+func TestSortDataCentersLikeState(t *testing.T) {
+	id1, id2, id3 := 1, 2, 3
+	dataCenters := []DataCenterStruct{{Name: "c", ID: &id3}, {Name: "renamed", ID: &id2}, {Name: "a", ID: &id1}, {Name: "b"}}
+	stateDataCenters := []interface{}{
+		map[string]interface{}{"name": "old-name", "dc_id": 2},
+		map[string]interface{}{"name": "a", "dc_id": 1},
+	}
+
+	names := []string{}
+	for _, dc := range sortDataCentersLikeState(dataCenters, stateDataCenters) {
+		names = append(names, dc.Name)
+	}
+	if fmt.Sprint(names) != "[renamed a b c]" {
+		t.Errorf("Expected [renamed a b c], got: %v", names)
+	}
+}
@@ -1,13 +1,74 @@
 package incapsula
 
 import (
+	"context"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+// Fields which only apply to some of the actions
+var incapRuleActionFields = []string{"response_code", "add_missing", "from", "to", "rewrite_name", "dc_id", "port_forwarding_context", "port_forwarding_value", "rate_context", "rate_interval", "error_type", "error_response_format", "error_response_data", "multiple_deletions", "override_waf_rule", "override_waf_action"}
+
+// incapRuleActionSpec lists the fields an action requires and the ones it optionally accepts
+type incapRuleActionSpec struct {
+	Required      []string
+	Optional      []string
+	ResponseCodes func(responseCode int) bool
+}
+
+var incapRuleRedirectResponseCodes = []int{301, 302, 303, 307, 308}
+var incapRuleCustomErrorResponseCodes = []int{400, 401, 402, 403, 404, 405, 406, 407, 408, 409, 410, 411, 412, 413, 414, 415, 416, 417, 419, 420, 422, 423, 424, 500, 501, 502, 503, 504, 505, 507}
+
+var incapRuleActions = map[string]incapRuleActionSpec{
+	"RULE_ACTION_REDIRECT":                       {Required: []string{"from", "to"}, Optional: []string{"response_code"}, ResponseCodes: incapRuleResponseCodeIn(incapRuleRedirectResponseCodes)},
+	"RULE_ACTION_SIMPLIFIED_REDIRECT":            {Required: []string{"from", "to"}, Optional: []string{"response_code"}, ResponseCodes: incapRuleResponseCodeIn(incapRuleRedirectResponseCodes)},
+	"RULE_ACTION_REWRITE_URL":                    {Required: []string{"from", "to"}},
+	"RULE_ACTION_REWRITE_HEADER":                 {Required: []string{"rewrite_name", "to"}, Optional: []string{"add_missing", "from"}},
+	"RULE_ACTION_REWRITE_COOKIE":                 {Required: []string{"rewrite_name", "to"}, Optional: []string{"add_missing", "from"}},
+	"RULE_ACTION_DELETE_HEADER":                  {Required: []string{"rewrite_name"}, Optional: []string{"multiple_deletions"}},
+	"RULE_ACTION_DELETE_COOKIE":                  {Required: []string{"rewrite_name"}},
+	"RULE_ACTION_RESPONSE_REWRITE_HEADER":        {Required: []string{"rewrite_name", "to"}, Optional: []string{"add_missing", "from"}},
+	"RULE_ACTION_RESPONSE_DELETE_HEADER":         {Required: []string{"rewrite_name"}, Optional: []string{"multiple_deletions"}},
+	"RULE_ACTION_RESPONSE_REWRITE_RESPONSE_CODE": {Required: []string{"response_code"}, ResponseCodes: func(responseCode int) bool { return responseCode >= 100 && responseCode <= 999 }},
+	"RULE_ACTION_FORWARD_TO_DC":                  {Required: []string{"dc_id"}},
+	"RULE_ACTION_FORWARD_TO_PORT":                {Required: []string{"port_forwarding_context", "port_forwarding_value"}},
+	"RULE_ACTION_ALERT":                          {},
+	"RULE_ACTION_BLOCK":                          {},
+	"RULE_ACTION_BLOCK_USER":                     {},
+	"RULE_ACTION_BLOCK_IP":                       {},
+	"RULE_ACTION_RETRY":                          {},
+	"RULE_ACTION_INTRUSIVE_HTML":                 {},
+	"RULE_ACTION_CAPTCHA":                        {},
+	"RULE_ACTION_RATE":                           {Required: []string{"rate_context", "rate_interval"}},
+	"RULE_ACTION_CUSTOM_ERROR_RESPONSE":          {Required: []string{"error_type"}, Optional: []string{"response_code", "error_response_format", "error_response_data"}, ResponseCodes: incapRuleResponseCodeIn(incapRuleCustomErrorResponseCodes)},
+	"RULE_ACTION_WAF_OVERRIDE":                   {Required: []string{"override_waf_rule", "override_waf_action"}},
+}
+
+func incapRuleResponseCodeIn(valid []int) func(int) bool {
+	return func(responseCode int) bool {
+		for _, v := range valid {
+			if v == responseCode {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func incapRuleActionSupportsField(spec incapRuleActionSpec, field string) bool {
+	for _, f := range append(spec.Required, spec.Optional...) {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
 func resourceIncapRule() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceIncapRuleCreate,
@@ -30,6 +91,19 @@ func resourceIncapRule() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			action := d.Get("action").(string)
+			if !d.NewValueKnown("action") || action == "" {
+				return nil
+			}
+			return validateIncapRuleActionFields(action, func(key string) (interface{}, bool) {
+				// Unknown values are assumed to be set
+				if !d.NewValueKnown(key) {
+					return nil, true
+				}
+				return d.GetOk(key)
+			})
+		},
 
 		Schema: map[string]*schema.Schema{
 			// Required Arguments
@@ -45,15 +119,18 @@ func resourceIncapRule() *schema.Resource {
 				Required:    true,
 			},
 			"action": {
-				Description: "Rule action. See the detailed descriptions in the API documentation. Possible values: `RULE_ACTION_REDIRECT`, `RULE_ACTION_SIMPLIFIED_REDIRECT`, `RULE_ACTION_REWRITE_URL`, `RULE_ACTION_REWRITE_HEADER`, `RULE_ACTION_REWRITE_COOKIE`, `RULE_ACTION_DELETE_HEADER`, `RULE_ACTION_DELETE_COOKIE`, `RULE_ACTION_RESPONSE_REWRITE_HEADER`, `RULE_ACTION_RESPONSE_DELETE_HEADER`, `RULE_ACTION_RESPONSE_REWRITE_RESPONSE_CODE`, `RULE_ACTION_FORWARD_TO_DC`, `RULE_ACTION_ALERT`, `RULE_ACTION_BLOCK`, `RULE_ACTION_BLOCK_USER`, `RULE_ACTION_BLOCK_IP`, `RULE_ACTION_RETRY`, `RULE_ACTION_INTRUSIVE_HTML`, `RULE_ACTION_CAPTCHA`, `RULE_ACTION_RATE`, `RULE_ACTION_CUSTOM_ERROR_RESPONSE`",
-				Type:        schema.TypeString,
-				Required:    true,
+				Description:  "Rule action. See the detailed descriptions in the API documentation. Possible values: `RULE_ACTION_REDIRECT`, `RULE_ACTION_SIMPLIFIED_REDIRECT`, `RULE_ACTION_REWRITE_URL`, `RULE_ACTION_REWRITE_HEADER`, `RULE_ACTION_REWRITE_COOKIE`, `RULE_ACTION_DELETE_HEADER`, `RULE_ACTION_DELETE_COOKIE`, `RULE_ACTION_RESPONSE_REWRITE_HEADER`, `RULE_ACTION_RESPONSE_DELETE_HEADER`, `RULE_ACTION_RESPONSE_REWRITE_RESPONSE_CODE`, `RULE_ACTION_FORWARD_TO_DC`, `RULE_ACTION_ALERT`, `RULE_ACTION_BLOCK`, `RULE_ACTION_BLOCK_USER`, `RULE_ACTION_BLOCK_IP`, `RULE_ACTION_RETRY`, `RULE_ACTION_INTRUSIVE_HTML`, `RULE_ACTION_CAPTCHA`, `RULE_ACTION_RATE`, `RULE_ACTION_CUSTOM_ERROR_RESPONSE`, `RULE_ACTION_FORWARD_TO_PORT`, `RULE_ACTION_WAF_OVERRIDE`",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(incapRuleActionNames(), false),
 			},
 			// Optional Arguments
 			"filter": {
-				Description: "The filter defines the conditions that trigger the rule action. For action `RULE_ACTION_SIMPLIFIED_REDIRECT` filter is not relevant. For other actions, if left empty, the rule is always run.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:      "The filter defines the conditions that trigger the rule action. For action `RULE_ACTION_SIMPLIFIED_REDIRECT` filter is not relevant. For other actions, if left empty, the rule is always run.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validateIncapRuleFilter,
+				DiffSuppressFunc: suppressEquivalentIncapRuleFilterDiffs,
 			},
 			"response_code": {
 				Description: "For `RULE_ACTION_REDIRECT` or `RULE_ACTION_SIMPLIFIED_REDIRECT` rule's response code, valid values are `302`, `301`, `303`, `307`, `308`. For `RULE_ACTION_RESPONSE_REWRITE_RESPONSE_CODE` rule's response code, valid values are all 3-digits numbers. For `RULE_ACTION_CUSTOM_ERROR_RESPONSE`, valid values are `400`, `401`, `402`, `403`, `404`, `405`, `406`, `407`, `408`, `409`, `410`, `411`, `412`, `413`, `414`, `415`, `416`, `417`, `419`, `420`, `422`, `423`, `424`, `500`, `501`, `502`, `503`, `504`, `505`, `507`.",
@@ -87,9 +164,10 @@ func resourceIncapRule() *schema.Resource {
 				ForceNew:    true,
 			},
 			"port_forwarding_context": {
-				Description: "Context for port forwarding. \"Use Port Value\" or \"Use Header Name\". Applies only for `RULE_ACTION_FORWARD_TO_PORT`.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:  "Context for port forwarding. \"Use Port Value\" or \"Use Header Name\". Applies only for `RULE_ACTION_FORWARD_TO_PORT`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"Use Port Value", "Use Header Name"}, false),
 			},
 			"port_forwarding_value": {
 				Description: "Port number or header name for port forwarding. Applies only for `RULE_ACTION_FORWARD_TO_PORT`.",
@@ -97,24 +175,28 @@ func resourceIncapRule() *schema.Resource {
 				Optional:    true,
 			},
 			"rate_context": {
-				Description: "The context of the rate counter. Possible values `IP` or `Session`. Applies only to rules using `RULE_ACTION_RATE`.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:  "The context of the rate counter. Possible values `IP` or `Session`. Applies only to rules using `RULE_ACTION_RATE`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"IP", "Session"}, false),
 			},
 			"rate_interval": {
-				Description: "The interval in seconds of the rate counter. Possible values is a multiple of `10`; minimum `10` and maximum `300`. Applies only to rules using `RULE_ACTION_RATE`.",
-				Type:        schema.TypeInt,
-				Optional:    true,
+				Description:  "The interval in seconds of the rate counter. Possible values is a multiple of `10`; minimum `10` and maximum `300`. Applies only to rules using `RULE_ACTION_RATE`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.All(validation.IntBetween(10, 300), validation.IntDivisibleBy(10)),
 			},
 			"error_type": {
-				Description: "The error that triggers the rule. `error.type.all` triggers the rule regardless of the error type. Applies only for `RULE_ACTION_CUSTOM_ERROR_RESPONSE`. Possible values: `error.type.all`, `error.type.connection_timeout`, `error.type.access_denied`, `error.type.parse_req_error`, `error.type.parse_resp_error`, `error.type.connection_failed`, `error.type.deny_and_retry`, `error.type.ssl_failed`, `error.type.deny_and_captcha`, `error.type.2fa_required`, `error.type.no_ssl_config`, `error.type.no_ipv6_config`.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:  "The error that triggers the rule. `error.type.all` triggers the rule regardless of the error type. Applies only for `RULE_ACTION_CUSTOM_ERROR_RESPONSE`. Possible values: `error.type.all`, `error.type.connection_timeout`, `error.type.access_denied`, `error.type.parse_req_error`, `error.type.parse_resp_error`, `error.type.connection_failed`, `error.type.deny_and_retry`, `error.type.ssl_failed`, `error.type.deny_and_captcha`, `error.type.2fa_required`, `error.type.no_ssl_config`, `error.type.no_ipv6_config`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"error.type.all", "error.type.connection_timeout", "error.type.access_denied", "error.type.parse_req_error", "error.type.parse_resp_error", "error.type.connection_failed", "error.type.deny_and_retry", "error.type.ssl_failed", "error.type.deny_and_captcha", "error.type.2fa_required", "error.type.no_ssl_config", "error.type.no_ipv6_config"}, false),
 			},
 			"error_response_format": {
-				Description: "The format of the given error response in the error_response_data field. Applies only for `RULE_ACTION_CUSTOM_ERROR_RESPONSE`. Possible values: `json`, `xml`.",
-				Type:        schema.TypeString,
-				Optional:    true,
+				Description:  "The format of the given error response in the error_response_data field. Applies only for `RULE_ACTION_CUSTOM_ERROR_RESPONSE`. Possible values: `json`, `xml`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"json", "xml"}, false),
 			},
 			"error_response_data": {
 				Description: "The response returned when the request matches the filter and is blocked. Applies only for `RULE_ACTION_CUSTOM_ERROR_RESPONSE`.",
@@ -140,6 +222,44 @@ func resourceIncapRule() *schema.Resource {
 	}
 }
 
+func incapRuleActionNames() []string {
+	actions := make([]string, 0, len(incapRuleActions))
+	for action := range incapRuleActions {
+		actions = append(actions, action)
+	}
+	sort.Strings(actions)
+	return actions
+}
+
+// validateIncapRuleActionFields checks the fields required and accepted by the action, and the response_code range
+func validateIncapRuleActionFields(action string, getOk func(key string) (interface{}, bool)) error {
+	spec, ok := incapRuleActions[action]
+	if !ok {
+		return fmt.Errorf("unsupported action %s", action)
+	}
+
+	for _, field := range spec.Required {
+		if _, ok := getOk(field); !ok {
+			return fmt.Errorf("%s is required for action %s", field, action)
+		}
+	}
+
+	for _, field := range incapRuleActionFields {
+		if _, ok := getOk(field); !ok {
+			continue
+		}
+		if !incapRuleActionSupportsField(spec, field) {
+			return fmt.Errorf("%s is not supported for action %s", field, action)
+		}
+	}
+
+	if responseCode, ok := getOk("response_code"); ok && responseCode != nil && spec.ResponseCodes != nil && !spec.ResponseCodes(responseCode.(int)) {
+		return fmt.Errorf("response_code %d is not supported for action %s", responseCode.(int), action)
+	}
+
+	return nil
+}
+
 func resourceIncapRuleCreate(d *schema.ResourceData, m interface{}) error {
 	client := m.(*Client)
 
@@ -35,6 +35,45 @@ func TestAccIncapsulaIncapRule_Basic(t *testing.T) {
 	})
 }
 
+func TestValidateIncapRuleActionFields(t *testing.T) {
+	valid := map[string]map[string]interface{}{
+		"RULE_ACTION_ALERT":                          {},
+		"RULE_ACTION_REDIRECT":                       {"response_code": 302, "from": "https://site1.com/url1", "to": "https://site2.com/url2"},
+		"RULE_ACTION_RESPONSE_REWRITE_RESPONSE_CODE": {"response_code": 418},
+		"RULE_ACTION_REWRITE_COOKIE":                 {"rewrite_name": "my_cookie_name", "from": "some_optional_value", "to": "some_new_value", "add_missing": true},
+		"RULE_ACTION_FORWARD_TO_DC":                  {"dc_id": nil},
+		"RULE_ACTION_RATE":                           {"rate_context": "IP", "rate_interval": 60},
+		"RULE_ACTION_CUSTOM_ERROR_RESPONSE":          {"error_type": "error.type.all", "response_code": 503, "error_response_format": "json", "error_response_data": "{}"},
+	}
+	for action, fields := range valid {
+		if err := validateIncapRuleActionFields(action, testIncapRuleFieldGetter(fields)); err != nil {
+			t.Errorf("Should not have received an error for action %s, got: %s", action, err)
+		}
+	}
+
+	invalid := map[string]map[string]interface{}{
+		"RULE_ACTION_UNKNOWN":                        {},
+		"RULE_ACTION_ALERT":                          {"dc_id": 123},
+		"RULE_ACTION_REDIRECT":                       {"response_code": 200, "from": "https://site1.com/url1", "to": "https://site2.com/url2"},
+		"RULE_ACTION_RESPONSE_REWRITE_RESPONSE_CODE": {},
+		"RULE_ACTION_FORWARD_TO_DC":                  {"dc_id": 123, "rate_interval": 60},
+		"RULE_ACTION_RATE":                           {"rate_context": "IP"},
+		"RULE_ACTION_CUSTOM_ERROR_RESPONSE":          {"error_type": "error.type.all", "response_code": 302},
+	}
+	for action, fields := range invalid {
+		if err := validateIncapRuleActionFields(action, testIncapRuleFieldGetter(fields)); err == nil {
+			t.Errorf("Should have received an error for action %s with fields %v", action, fields)
+		}
+	}
+}
+
+func testIncapRuleFieldGetter(fields map[string]interface{}) func(string) (interface{}, bool) {
+	return func(key string) (interface{}, bool) {
+		value, ok := fields[key]
+		return value, ok
+	}
+}
+
 func testAccStateRuleID(s *terraform.State) (string, error) {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "incapsula_incap_rule" {
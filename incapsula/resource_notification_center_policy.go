@@ -1,14 +1,95 @@
 package incapsula
 
 import (
+	"context"
 	"fmt"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 )
 
+// validateNotificationCenterPolicyAssets checks the sub category, and the asset types and apply_to_new_assets against
+// the sub categories available to the account, sub categories without asset types don't support assets
+func validateNotificationCenterPolicyAssets(subCategories []NotificationSubCategoryDto, subCategory, policyType, applyToNewAssets string, assetTypes []string) error {
+	var supportedAssetTypes []string
+	names := make([]string, 0, len(subCategories))
+	found := false
+	for _, dto := range subCategories {
+		names = append(names, dto.SubCategory)
+		if dto.SubCategory == subCategory {
+			supportedAssetTypes = dto.AssetTypes
+			found = true
+		}
+	}
+	if !found {
+		sort.Strings(names)
+		return fmt.Errorf("unsupported sub_category %s, supported values are: %s", subCategory, strings.Join(names, ", "))
+	}
+
+	if len(supportedAssetTypes) == 0 {
+		if len(assetTypes) > 0 {
+			return fmt.Errorf("sub_category %s does not support assets", subCategory)
+		}
+		if applyToNewAssets == "TRUE" {
+			return fmt.Errorf("apply_to_new_assets is not supported for sub_category %s, which does not support assets", subCategory)
+		}
+		return nil
+	}
+
+	for _, assetType := range assetTypes {
+		supported := false
+		for _, supportedAssetType := range supportedAssetTypes {
+			if assetType == supportedAssetType {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return fmt.Errorf("asset_type %s is not supported for sub_category %s, supported values are: %s", assetType, subCategory, strings.Join(supportedAssetTypes, ", "))
+		}
+	}
+
+	if policyType == "ACCOUNT" && applyToNewAssets != "TRUE" && len(assetTypes) == 0 {
+		return fmt.Errorf("at least one asset is required for sub_category %s when apply_to_new_assets is FALSE", subCategory)
+	}
+
+	return nil
+}
+
+// validateNotificationCenterPolicySubCategory fails the plan when the sub category or the assets are not supported.
+// The sub categories are only fetched when the policy changes, and the validation is skipped when they can't be fetched.
+func validateNotificationCenterPolicySubCategory(d *schema.ResourceDiff, m interface{}) error {
+	changed := d.Id() == ""
+	for _, key := range []string{"account_id", "sub_category", "policy_type", "apply_to_new_assets", "asset"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+		changed = changed || d.HasChange(key)
+	}
+	if !changed {
+		return nil
+	}
+
+	subCategories, err := m.(*Client).GetNotificationCenterSubCategories(d.Get("account_id").(int))
+	if err != nil {
+		log.Printf("[WARN] Could not validate the sub_category of the notification policy: %s\n", err)
+		return nil
+	}
+
+	var assetTypes []string
+	for _, asset := range d.Get("asset").(*schema.Set).List() {
+		if assetType := asset.(map[string]interface{})["asset_type"].(string); assetType != "" {
+			assetTypes = append(assetTypes, assetType)
+		}
+	}
+	return validateNotificationCenterPolicyAssets(subCategories.Data, d.Get("sub_category").(string), d.Get("policy_type").(string), d.Get("apply_to_new_assets").(string), assetTypes)
+}
+
 var assetResource = schema.Resource{
 	Schema: map[string]*schema.Schema{
 		"asset_type": {
@@ -25,6 +106,325 @@ var assetResource = schema.Resource{
 	},
 }
 
+var notificationChannelResource = schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"type": {
+			Type:         schema.TypeString,
+			Description:  "The channel type: email or webhook",
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{notificationChannelTypeEmail, notificationChannelTypeWebhook}, false),
+		},
+
+		// email
+		"user_recipient_list": {
+			Type:        schema.TypeList,
+			Description: "List of Imperva users id to get the notifications",
+			Elem:        &schema.Schema{Type: schema.TypeInt},
+			Optional:    true,
+		},
+		"external_recipient_list": {
+			Type:        schema.TypeList,
+			Description: "List of external email to get the notifications (not Imperva users)",
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Optional:    true,
+		},
+
+		// webhook
+		"url": {
+			Type:         schema.TypeString,
+			Description:  "The webhook URL",
+			Optional:     true,
+			ValidateFunc: validation.IsURLWithHTTPS,
+		},
+		"auth_header_name": {
+			Type:        schema.TypeString,
+			Description: "The name of the authentication header sent to the webhook",
+			Optional:    true,
+		},
+		"auth_header_value": {
+			Type:        schema.TypeString,
+			Description: "The value of the authentication header sent to the webhook",
+			Optional:    true,
+			Sensitive:   true,
+		},
+		"format": {
+			Type:        schema.TypeString,
+			Description: "The format of the notifications sent to the webhook. Default value is JSON",
+			Optional:    true,
+			Computed:    true,
+		},
+	},
+}
+
+// Fields of the channel block supported by each channel type, the first ones are required
+var notificationChannelTypeFields = map[string]struct {
+	Required []string
+	Optional []string
+}{
+	notificationChannelTypeEmail:   {Optional: []string{"user_recipient_list", "external_recipient_list"}},
+	notificationChannelTypeWebhook: {Required: []string{"url"}, Optional: []string{"auth_header_name", "auth_header_value", "format"}},
+}
+
+// validateNotificationChannel checks the fields of a channel block against its type
+func validateNotificationChannel(channelType string, getOk func(key string) (interface{}, bool)) error {
+	fields, ok := notificationChannelTypeFields[channelType]
+	if !ok {
+		return fmt.Errorf("unsupported channel type %s", channelType)
+	}
+
+	for _, field := range fields.Required {
+		if _, ok := getOk(field); !ok {
+			return fmt.Errorf("%s is required for channel type %s", field, channelType)
+		}
+	}
+
+	for otherType, otherFields := range notificationChannelTypeFields {
+		if otherType == channelType {
+			continue
+		}
+		for _, field := range append(append([]string{}, otherFields.Required...), otherFields.Optional...) {
+			if _, ok := getOk(field); ok {
+				return fmt.Errorf("%s is not supported for channel type %s", field, channelType)
+			}
+		}
+	}
+
+	if channelType == notificationChannelTypeEmail {
+		_, hasUsers := getOk("user_recipient_list")
+		_, hasExternal := getOk("external_recipient_list")
+		if !hasUsers && !hasExternal {
+			return fmt.Errorf("user_recipient_list or external_recipient_list is required for channel type %s", channelType)
+		}
+	}
+
+	return nil
+}
+
+// getNotificationChannelConfigOk returns a field of a channel block of the configuration, unlike the planned value,
+// a computed field which is not configured isn't taken from the channel at the same index in the state
+func getNotificationChannelConfigOk(channel cty.Value, key string) (interface{}, bool) {
+	value := channel.GetAttr(key)
+	switch {
+	case !value.IsKnown():
+		// Unknown values are assumed to be set
+		return nil, true
+	case value.IsNull():
+		return nil, false
+	case value.CanIterateElements() && value.LengthInt() == 0:
+		return nil, false
+	case value.Type() == cty.String && value.AsString() == "":
+		return nil, false
+	}
+
+	return value, true
+}
+
+// validateNotificationCenterPolicyChannelsConfig checks the channel blocks of the configuration, and returns the number of
+// email channels
+func validateNotificationCenterPolicyChannelsConfig(channels cty.Value) (int, error) {
+	emailChannels := 0
+	if !channels.IsKnown() || channels.IsNull() {
+		return emailChannels, nil
+	}
+
+	i := 0
+	for it := channels.ElementIterator(); it.Next(); i++ {
+		_, channel := it.Element()
+		if !channel.IsKnown() || channel.IsNull() || !channel.GetAttr("type").IsKnown() || channel.GetAttr("type").IsNull() {
+			continue
+		}
+
+		channelType := channel.GetAttr("type").AsString()
+		if channelType == notificationChannelTypeEmail {
+			emailChannels++
+		}
+
+		err := validateNotificationChannel(channelType, func(key string) (interface{}, bool) {
+			return getNotificationChannelConfigOk(channel, key)
+		})
+		if err != nil {
+			return emailChannels, fmt.Errorf("channel %d: %s", i, err)
+		}
+	}
+
+	return emailChannels, nil
+}
+
+func validateNotificationCenterPolicyChannels(d *schema.ResourceDiff) error {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() {
+		return nil
+	}
+	emailChannels, err := validateNotificationCenterPolicyChannelsConfig(rawConfig.GetAttr("channel"))
+	if err != nil {
+		return err
+	}
+
+	if emailChannels > 1 {
+		return fmt.Errorf("only one channel of type %s is supported", notificationChannelTypeEmail)
+	}
+	if emailChannels > 0 && (len(d.Get("emailchannel_user_recipient_list").([]interface{})) > 0 || len(d.Get("emailchannel_external_recipient_list").([]interface{})) > 0) {
+		return fmt.Errorf("a channel of type %s conflicts with emailchannel_user_recipient_list and emailchannel_external_recipient_list", notificationChannelTypeEmail)
+	}
+
+	return nil
+}
+
+// Sub account targeting modes of a SUB_ACCOUNT policy
+const notificationSubAccountTargetingAll = "ALL"
+const notificationSubAccountTargetingList = "LIST"
+const notificationSubAccountTargetingRefID = "REF_ID"
+
+// Field of the sub_account_targeting block required by each mode, the other fields are not supported
+var notificationSubAccountTargetingFields = map[string]string{
+	notificationSubAccountTargetingAll:   "",
+	notificationSubAccountTargetingList:  "sub_account_ids",
+	notificationSubAccountTargetingRefID: "ref_id_regex",
+}
+
+var notificationSubAccountTargetingResource = schema.Resource{
+	Schema: map[string]*schema.Schema{
+		"mode": {
+			Type:         schema.TypeString,
+			Description:  "ALL: all the sub accounts, including new ones. LIST: the sub_account_ids. REF_ID: the sub accounts whose ref_id matches ref_id_regex",
+			Required:     true,
+			ValidateFunc: validation.StringInSlice([]string{notificationSubAccountTargetingAll, notificationSubAccountTargetingList, notificationSubAccountTargetingRefID}, false),
+		},
+		"sub_account_ids": {
+			Type:        schema.TypeSet,
+			Description: "Mode LIST. Numeric identifiers of the sub accounts",
+			Elem:        &schema.Schema{Type: schema.TypeInt},
+			Optional:    true,
+		},
+		"ref_id_regex": {
+			Type:         schema.TypeString,
+			Description:  "Mode REF_ID. Regular expression matched against the ref_id of the sub accounts",
+			Optional:     true,
+			ValidateFunc: validation.StringIsValidRegExp,
+		},
+	},
+}
+
+// validateNotificationSubAccountTargeting checks the fields of the sub_account_targeting block against its mode
+func validateNotificationSubAccountTargeting(mode string, getOk func(key string) (interface{}, bool)) error {
+	requiredField, ok := notificationSubAccountTargetingFields[mode]
+	if !ok {
+		return fmt.Errorf("unsupported sub_account_targeting mode %s", mode)
+	}
+
+	for _, field := range notificationSubAccountTargetingFields {
+		if field == "" {
+			continue
+		}
+		_, isSet := getOk(field)
+		if field == requiredField && !isSet {
+			return fmt.Errorf("%s is required for sub_account_targeting mode %s", field, mode)
+		}
+		if field != requiredField && isSet {
+			return fmt.Errorf("%s is not supported for sub_account_targeting mode %s", field, mode)
+		}
+	}
+
+	return nil
+}
+
+// resolveNotificationSubAccountTargeting returns the sorted ids of the sub accounts targeted by a mode,
+// and whether new sub accounts are automatically added to the policy
+func resolveNotificationSubAccountTargeting(mode string, subAccountIDs []int, refIDRegex string, subAccounts []SubAccount) ([]int, string, error) {
+	ids := []int{}
+	applyToNewSubAccounts := "FALSE"
+
+	switch mode {
+	case notificationSubAccountTargetingAll:
+		for _, subAccount := range subAccounts {
+			ids = append(ids, subAccount.SubAccountID)
+		}
+		applyToNewSubAccounts = "TRUE"
+	case notificationSubAccountTargetingList:
+		ids = append(ids, subAccountIDs...)
+	case notificationSubAccountTargetingRefID:
+		refIDPattern, err := regexp.Compile(refIDRegex)
+		if err != nil {
+			return nil, "", fmt.Errorf("invalid ref_id_regex %s: %s", refIDRegex, err)
+		}
+		for _, subAccount := range subAccounts {
+			if subAccount.SubAccountPayload != nil && refIDPattern.MatchString(subAccount.RefID) {
+				ids = append(ids, subAccount.SubAccountID)
+			}
+		}
+	default:
+		return nil, "", fmt.Errorf("unsupported sub_account_targeting mode %s", mode)
+	}
+	sort.Ints(ids)
+
+	return ids, applyToNewSubAccounts, nil
+}
+
+// customizeNotificationCenterPolicySubAccountsDiff validates the sub_account_targeting block, and resolves the targeted
+// sub accounts so sub accounts starting or stopping to match are shown in the plan
+func customizeNotificationCenterPolicySubAccountsDiff(d *schema.ResourceDiff, m interface{}) error {
+	if len(d.Get("sub_account_targeting").([]interface{})) == 0 {
+		if d.HasChange("sub_account_list") || d.HasChange("apply_to_new_sub_accounts") || d.HasChange("sub_account_targeting") {
+			return d.SetNewComputed("effective_sub_account_ids")
+		}
+		return nil
+	}
+
+	prefix := "sub_account_targeting.0."
+	if !d.NewValueKnown(prefix + "mode") {
+		return d.SetNewComputed("effective_sub_account_ids")
+	}
+	mode := d.Get(prefix + "mode").(string)
+	err := validateNotificationSubAccountTargeting(mode, func(key string) (interface{}, bool) {
+		// Unknown values are assumed to be set
+		if !d.NewValueKnown(prefix + key) {
+			return nil, true
+		}
+		return d.GetOk(prefix + key)
+	})
+	if err != nil {
+		return err
+	}
+
+	if d.NewValueKnown("policy_type") && d.Get("policy_type").(string) != "SUB_ACCOUNT" {
+		return fmt.Errorf("sub_account_targeting is only supported for policy_type SUB_ACCOUNT")
+	}
+	if len(d.Get("sub_account_list").([]interface{})) > 0 || d.Get("apply_to_new_sub_accounts").(string) == "TRUE" {
+		return fmt.Errorf("sub_account_targeting conflicts with sub_account_list and apply_to_new_sub_accounts")
+	}
+
+	for _, key := range []string{"account_id", prefix + "sub_account_ids", prefix + "ref_id_regex"} {
+		if !d.NewValueKnown(key) {
+			return d.SetNewComputed("effective_sub_account_ids")
+		}
+	}
+
+	var subAccounts []SubAccount
+	if mode != notificationSubAccountTargetingList {
+		accountID := d.Get("account_id").(int)
+		subAccounts, err = m.(*Client).ListSubAccounts(accountID)
+		if err != nil {
+			log.Printf("[WARN] Could not resolve the sub accounts targeted by the notification policy for account id %d: %s\n", accountID, err)
+			return d.SetNewComputed("effective_sub_account_ids")
+		}
+	}
+
+	ids, _, err := resolveNotificationSubAccountTargeting(mode, expandNotificationSubAccountIDs(d.Get(prefix+"sub_account_ids").(*schema.Set)), d.Get(prefix+"ref_id_regex").(string), subAccounts)
+	if err != nil {
+		return err
+	}
+
+	return d.SetNew("effective_sub_account_ids", ids)
+}
+
+func expandNotificationSubAccountIDs(set *schema.Set) []int {
+	ids := make([]int, 0, set.Len())
+	for _, id := range set.List() {
+		ids = append(ids, id.(int))
+	}
+	return ids
+}
+
 func resourceNotificationCenterPolicy() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceNotificationCenterPolicyCreate,
@@ -67,6 +467,16 @@ func resourceNotificationCenterPolicy() *schema.Resource {
 				return []*schema.ResourceData{d}, nil
 			},
 		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			if err := validateNotificationCenterPolicyChannels(d); err != nil {
+				return err
+			}
+			if err := customizeNotificationCenterPolicySubAccountsDiff(d, m); err != nil {
+				return err
+			}
+
+			return validateNotificationCenterPolicySubCategory(d, m)
+		},
 
 		Schema: map[string]*schema.Schema{
 			"account_id": {
@@ -101,7 +511,8 @@ func resourceNotificationCenterPolicy() *schema.Resource {
 				Elem: &schema.Schema{
 					Type: schema.TypeInt,
 				},
-				Optional: true,
+				Optional:   true,
+				Deprecated: "Use a channel block of type email instead",
 			},
 			"emailchannel_external_recipient_list": {
 				Description: "List of external email to get the notifications (not Imperva users)",
@@ -109,7 +520,14 @@ func resourceNotificationCenterPolicy() *schema.Resource {
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
-				Optional: true,
+				Optional:   true,
+				Deprecated: "Use a channel block of type email instead",
+			},
+			"channel": {
+				Description: "Notification channels: email recipients, or a webhook to send the notifications to",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &notificationChannelResource,
 			},
 
 			"asset": {
@@ -156,6 +574,20 @@ func resourceNotificationCenterPolicy() *schema.Resource {
 				},
 				Optional: true,
 			},
+			"sub_account_targeting": {
+				Description: "The sub accounts of a SUB_ACCOUNT policy: all the sub accounts, an explicit list, " +
+					"or the sub accounts whose ref_id matches a regular expression. Conflicts with sub_account_list and apply_to_new_sub_accounts",
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem:     &notificationSubAccountTargetingResource,
+			},
+			"effective_sub_account_ids": {
+				Description: "The sorted ids of the sub accounts the policy applies to",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
 		},
 	}
 }
@@ -168,6 +600,9 @@ func resourceNotificationCenterPolicyUpdate(data *schema.ResourceData, i interfa
 	log.Printf("[INFO] Updateding NotificationCenterPolicy with policyId:%d accountId:%d and name: %s\n",
 		notificationCenterPolicyId, accountId, notificationCenterPolicyName)
 	notificationPolicyFullDto := getNotificationCenterPolicyFromResource(data)
+	if err := setNotificationCenterPolicyTargetedSubAccounts(client, data, &notificationPolicyFullDto); err != nil {
+		return err
+	}
 	notificationCenterPolicyUpdateResponse, err := client.UpdateNotificationCenterPolicy(&notificationPolicyFullDto)
 	if err != nil {
 		log.Printf("[ERROR] Could not update NotificationCenterPolicy id:%d. \nThe policy: %+v  \nThe response:%+v \nThe error: %s\n",
@@ -185,6 +620,9 @@ func resourceNotificationCenterPolicyCreate(data *schema.ResourceData, i interfa
 	notificationCenterPolicyName := data.Get("policy_name").(string)
 	log.Printf("[INFO] Creating NotificationCenterPolicy: %s\n", notificationCenterPolicyName)
 	notificationPolicyFullDto := getNotificationCenterPolicyFromResource(data)
+	if err := setNotificationCenterPolicyTargetedSubAccounts(client, data, &notificationPolicyFullDto); err != nil {
+		return err
+	}
 	notificationCenterPolicyAddResponse, err := client.AddNotificationCenterPolicy(&notificationPolicyFullDto)
 
 	if err != nil {
@@ -234,14 +672,14 @@ func getNotificationCenterPolicyFromResource(data *schema.ResourceData) Notifica
 
 	assetList := getAssetsFromResource(data)
 	subAccountsDtoList := getSubAccountsDtoListFromResource(data)
-	notificationChannelList := getEmailChannelFromResource(data)
+	notificationChannelList := getNotificationChannelsFromResource(data)
 	notificationPolicyFullDto := NotificationPolicyFullDto{
 		PolicyId:                policyId,
 		AccountId:               data.Get("account_id").(int),
 		PolicyName:              data.Get("policy_name").(string),
 		Status:                  data.Get("status").(string),
 		SubCategory:             data.Get("sub_category").(string),
-		NotificationChannelList: []NotificationChannelEmailDto{notificationChannelList},
+		NotificationChannelList: notificationChannelList,
 		AssetList:               assetList,
 		ApplyToNewAssets:        data.Get("apply_to_new_assets").(string),
 		PolicyType:              data.Get("policy_type").(string),
@@ -255,29 +693,64 @@ func getNotificationCenterPolicyFromResource(data *schema.ResourceData) Notifica
 	return notificationPolicyFullDto
 }
 
-func getEmailChannelFromResource(data *schema.ResourceData) NotificationChannelEmailDto {
+func getNotificationChannelsFromResource(data *schema.ResourceData) []NotificationChannelDto {
+	var notificationChannelList []NotificationChannelDto
+	hasEmailChannel := false
+	for _, channel := range data.Get("channel").([]interface{}) {
+		channelResource := channel.(map[string]interface{})
+		channelType := channelResource["type"].(string)
+		notificationChannel := NotificationChannelDto{ChannelType: channelType}
+
+		switch channelType {
+		case notificationChannelTypeEmail:
+			hasEmailChannel = true
+			notificationChannel.RecipientToList = getRecipientsFromLists(
+				channelResource["user_recipient_list"].([]interface{}),
+				channelResource["external_recipient_list"].([]interface{}))
+		case notificationChannelTypeWebhook:
+			notificationChannel.Url = channelResource["url"].(string)
+			notificationChannel.AuthHeaderName = channelResource["auth_header_name"].(string)
+			notificationChannel.AuthHeaderValue = channelResource["auth_header_value"].(string)
+			notificationChannel.Format = channelResource["format"].(string)
+			if notificationChannel.Format == "" {
+				notificationChannel.Format = "JSON"
+			}
+		}
+		notificationChannelList = append(notificationChannelList, notificationChannel)
+	}
+
+	// The deprecated emailchannel_* arguments
+	emailChannel := getEmailChannelFromResource(data)
+	if !hasEmailChannel && (len(emailChannel.RecipientToList) > 0 || len(notificationChannelList) == 0) {
+		notificationChannelList = append([]NotificationChannelDto{emailChannel}, notificationChannelList...)
+	}
+
+	return notificationChannelList
+}
+
+func getRecipientsFromLists(usersIds, externalUsersEmail []interface{}) []RecipientDto {
 	var userRecipientDto []RecipientDto
-	usersIds := data.Get("emailchannel_user_recipient_list").([]interface{})
 	for _, userId := range usersIds {
-		recipientDto := RecipientDto{
+		userRecipientDto = append(userRecipientDto, RecipientDto{
 			RecipientType: "User",
 			Id:            userId.(int),
-		}
-		userRecipientDto = append(userRecipientDto, recipientDto)
+		})
 	}
-
-	externalUsersEmail := data.Get("emailchannel_external_recipient_list").([]interface{})
 	for _, userEmail := range externalUsersEmail {
-		recipientDto := RecipientDto{
+		userRecipientDto = append(userRecipientDto, RecipientDto{
 			RecipientType: "External",
 			DisplayName:   userEmail.(string),
-		}
-		userRecipientDto = append(userRecipientDto, recipientDto)
+		})
 	}
+	return userRecipientDto
+}
 
-	notificationChannelList := NotificationChannelEmailDto{
-		ChannelType:     "email",
-		RecipientToList: userRecipientDto,
+func getEmailChannelFromResource(data *schema.ResourceData) NotificationChannelDto {
+	notificationChannelList := NotificationChannelDto{
+		ChannelType: notificationChannelTypeEmail,
+		RecipientToList: getRecipientsFromLists(
+			data.Get("emailchannel_user_recipient_list").([]interface{}),
+			data.Get("emailchannel_external_recipient_list").([]interface{})),
 	}
 	return notificationChannelList
 }
@@ -292,6 +765,42 @@ func getSubAccountsDtoListFromResource(d *schema.ResourceData) []SubAccountDTO {
 	return subAccountsDtoList
 }
 
+// setNotificationCenterPolicyTargetedSubAccounts sets the sub accounts targeted by the sub_account_targeting block to the policy
+func setNotificationCenterPolicyTargetedSubAccounts(client *Client, data *schema.ResourceData, policy *NotificationPolicyFullDto) error {
+	targeting := data.Get("sub_account_targeting").([]interface{})
+	if len(targeting) == 0 || targeting[0] == nil {
+		return nil
+	}
+	targetingResource := targeting[0].(map[string]interface{})
+	mode := targetingResource["mode"].(string)
+
+	var subAccounts []SubAccount
+	var err error
+	if mode != notificationSubAccountTargetingList {
+		subAccounts, err = client.ListSubAccounts(policy.AccountId)
+		if err != nil {
+			return fmt.Errorf("Error listing the sub accounts of account id %d: %s", policy.AccountId, err)
+		}
+	}
+
+	ids, applyToNewSubAccounts, err := resolveNotificationSubAccountTargeting(mode, expandNotificationSubAccountIDs(targetingResource["sub_account_ids"].(*schema.Set)), targetingResource["ref_id_regex"].(string), subAccounts)
+	if err != nil {
+		return err
+	}
+	log.Printf("[DEBUG] NotificationCenter policy sub_account_targeting mode %s targets the sub accounts: %v", mode, ids)
+
+	subAccountsDtoList := make([]SubAccountDTO, 0, len(ids))
+	for _, id := range ids {
+		subAccountsDtoList = append(subAccountsDtoList, SubAccountDTO{SubAccountId: id})
+	}
+	policy.SubAccountPolicyInfo = SubAccountPolicyInfo{
+		ApplyToNewSubAccounts: applyToNewSubAccounts,
+		SubAccountList:        subAccountsDtoList,
+	}
+
+	return nil
+}
+
 func getAssetsFromResource(d *schema.ResourceData) []AssetDto {
 	var assetList []AssetDto
 	assets := d.Get("asset").(*schema.Set)
@@ -325,18 +834,28 @@ func resourceNotificationCenterPolicyRead(data *schema.ResourceData, i interface
 	data.Set("policy_name", notificationCenterPolicy.Data.PolicyName)
 	data.Set("status", notificationCenterPolicy.Data.Status)
 	data.Set("sub_category", notificationCenterPolicy.Data.SubCategory)
-	handleEmailChannelRead(data, notificationCenterPolicy)
+	handleChannelsRead(data, notificationCenterPolicy)
 	handleAssetsRead(data, notificationCenterPolicy)
 	data.Set("apply_to_new_assets", notificationCenterPolicy.Data.ApplyToNewAssets)
 	data.Set("policy_type", notificationCenterPolicy.Data.PolicyType)
-	data.Set("apply_to_new_sub_accounts", notificationCenterPolicy.Data.SubAccountPolicyInfo.ApplyToNewSubAccounts)
 
 	subAccountList := make([]int, 0)
 	for _, subAccount := range notificationCenterPolicy.Data.SubAccountPolicyInfo.SubAccountList {
 		subAccountList = append(subAccountList, subAccount.SubAccountId)
 	}
 
-	data.Set("sub_account_list", subAccountList)
+	// The sub accounts targeted by the sub_account_targeting block are only set to effective_sub_account_ids
+	if len(data.Get("sub_account_targeting").([]interface{})) > 0 {
+		data.Set("apply_to_new_sub_accounts", "FALSE")
+		data.Set("sub_account_list", nil)
+	} else {
+		data.Set("apply_to_new_sub_accounts", notificationCenterPolicy.Data.SubAccountPolicyInfo.ApplyToNewSubAccounts)
+		data.Set("sub_account_list", subAccountList)
+	}
+
+	effectiveSubAccountIDs := append([]int{}, subAccountList...)
+	sort.Ints(effectiveSubAccountIDs)
+	data.Set("effective_sub_account_ids", effectiveSubAccountIDs)
 	log.Printf("[INFO] Finished reading notificationCenterPolicy: %s\n", data.Id())
 
 	return nil
@@ -356,6 +875,94 @@ func handleAssetsRead(data *schema.ResourceData, notificationCenterPolicy *Notif
 	data.Set("asset", assetSet)
 }
 
+// handleChannelsRead sets the channel blocks, the email channel is set to the deprecated emailchannel_* arguments
+// unless the channel blocks already have an email channel
+// getNotificationChannelKey identifies a channel block by type and URL, there is only one email channel
+func getNotificationChannelKey(channel map[string]interface{}) string {
+	url, _ := channel["url"].(string)
+	return channel["type"].(string) + " " + url
+}
+
+// sortNotificationChannelsLikeState orders the channels read from the API like the state, which follows the configuration,
+// the other channels are last in the API order
+func sortNotificationChannelsLikeState(channels []interface{}, stateChannels []interface{}) []interface{} {
+	positions := map[string]int{}
+	for i, channel := range stateChannels {
+		positions[getNotificationChannelKey(channel.(map[string]interface{}))] = i
+	}
+
+	position := func(channel interface{}) int {
+		if i, ok := positions[getNotificationChannelKey(channel.(map[string]interface{}))]; ok {
+			return i
+		}
+		return len(stateChannels)
+	}
+
+	sorted := append([]interface{}{}, channels...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return position(sorted[i]) < position(sorted[j])
+	})
+
+	return sorted
+}
+
+func handleChannelsRead(data *schema.ResourceData, notificationCenterPolicy *NotificationPolicy) {
+	hasEmailChannel := false
+	authHeaderValues := map[string]string{}
+	for _, channel := range data.Get("channel").([]interface{}) {
+		channelResource := channel.(map[string]interface{})
+		if channelResource["type"].(string) == notificationChannelTypeEmail {
+			hasEmailChannel = true
+		}
+		if url, _ := channelResource["url"].(string); url != "" {
+			authHeaderValues[url] = channelResource["auth_header_value"].(string)
+		}
+	}
+
+	channels := make([]interface{}, 0, len(notificationCenterPolicy.Data.NotificationChannelList))
+	for _, channel := range notificationCenterPolicy.Data.NotificationChannelList {
+		switch {
+		case channel.ChannelType == notificationChannelTypeEmail && hasEmailChannel:
+			var userRecipients []int
+			var externalRecipients []string
+			for _, recipient := range channel.RecipientToList {
+				switch recipient.RecipientType {
+				case "External":
+					externalRecipients = append(externalRecipients, recipient.DisplayName)
+				case "User":
+					userRecipients = append(userRecipients, recipient.Id)
+				}
+			}
+			channels = append(channels, map[string]interface{}{
+				"type":                    notificationChannelTypeEmail,
+				"user_recipient_list":     userRecipients,
+				"external_recipient_list": externalRecipients,
+			})
+		case channel.ChannelType != notificationChannelTypeEmail:
+			// The authentication header value is not returned by the API
+			authHeaderValue := channel.AuthHeaderValue
+			if authHeaderValue == "" {
+				authHeaderValue = authHeaderValues[channel.Url]
+			}
+			channels = append(channels, map[string]interface{}{
+				"type":              channel.ChannelType,
+				"url":               channel.Url,
+				"auth_header_name":  channel.AuthHeaderName,
+				"auth_header_value": authHeaderValue,
+				"format":            channel.Format,
+			})
+		}
+	}
+	data.Set("channel", sortNotificationChannelsLikeState(channels, data.Get("channel").([]interface{})))
+
+	if !hasEmailChannel {
+		handleEmailChannelRead(data, notificationCenterPolicy)
+	} else {
+		data.Set("emailchannel_user_recipient_list", nil)
+		data.Set("emailchannel_external_recipient_list", nil)
+	}
+}
+
 func handleEmailChannelRead(data *schema.ResourceData, notificationCenterPolicy *NotificationPolicy) {
 	var emailChannelUserRecipientsList []int
 	var emailChannelExternalRecipientsList []string
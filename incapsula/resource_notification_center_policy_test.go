@@ -2,7 +2,9 @@ package incapsula
 
 import (
 	"fmt"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"log"
 	"strconv"
@@ -198,3 +200,222 @@ resource "%s" "%s" {
 		notificationCenterPolicyResourceType, policy2AccountWithoutAssets,
 	)
 }
+
+func TestValidateNotificationChannel(t *testing.T) {
+	cases := []struct {
+		channelType string
+		fields      map[string]interface{}
+		expectedErr string
+	}{
+		{notificationChannelTypeEmail, map[string]interface{}{"user_recipient_list": []interface{}{1}}, ""},
+		{notificationChannelTypeEmail, map[string]interface{}{}, "user_recipient_list or external_recipient_list is required for channel type email"},
+		{notificationChannelTypeEmail, map[string]interface{}{"external_recipient_list": []interface{}{"a@b.com"}, "url": "https://hooks.example.com"}, "url is not supported for channel type email"},
+		{notificationChannelTypeWebhook, map[string]interface{}{"url": "https://hooks.example.com", "format": "JSON"}, ""},
+		{notificationChannelTypeWebhook, map[string]interface{}{"auth_header_name": "Authorization"}, "url is required for channel type webhook"},
+		{notificationChannelTypeWebhook, map[string]interface{}{"url": "https://hooks.example.com", "user_recipient_list": []interface{}{1}}, "user_recipient_list is not supported for channel type webhook"},
+		{"sms", map[string]interface{}{}, "unsupported channel type sms"},
+	}
+
+	for _, c := range cases {
+		err := validateNotificationChannel(c.channelType, func(key string) (interface{}, bool) {
+			value, ok := c.fields[key]
+			return value, ok
+		})
+		if c.expectedErr == "" && err != nil {
+			t.Errorf("%s %v: should not have received an error, got: %s", c.channelType, c.fields, err)
+		}
+		if c.expectedErr != "" && (err == nil || err.Error() != c.expectedErr) {
+			t.Errorf("%s %v: expected error %q, got: %v", c.channelType, c.fields, c.expectedErr, err)
+		}
+	}
+}
+
+// notificationChannelConfig returns a channel block of the configuration, the fields which are not set are null
+func notificationChannelConfig(channelType string, fields map[string]cty.Value) cty.Value {
+	attributes := map[string]cty.Value{
+		"type":                    cty.StringVal(channelType),
+		"user_recipient_list":     cty.NullVal(cty.List(cty.Number)),
+		"external_recipient_list": cty.NullVal(cty.List(cty.String)),
+		"url":                     cty.NullVal(cty.String),
+		"auth_header_name":        cty.NullVal(cty.String),
+		"auth_header_value":       cty.NullVal(cty.String),
+		"format":                  cty.NullVal(cty.String),
+	}
+	for key, value := range fields {
+		attributes[key] = value
+	}
+
+	return cty.ObjectVal(attributes)
+}
+
+func TestValidateNotificationCenterPolicyChannelsConfig(t *testing.T) {
+	email := notificationChannelConfig(notificationChannelTypeEmail, map[string]cty.Value{
+		"external_recipient_list": cty.ListVal([]cty.Value{cty.StringVal("a@b.com")}),
+	})
+	webhook := notificationChannelConfig(notificationChannelTypeWebhook, map[string]cty.Value{
+		"url": cty.StringVal("https://hooks.example.com"),
+	})
+
+	// An email channel replacing a webhook channel at the same index, the format computed for the webhook is not configured
+	emailChannels, err := validateNotificationCenterPolicyChannelsConfig(cty.ListVal([]cty.Value{email, webhook}))
+	if err != nil || emailChannels != 1 {
+		t.Errorf("Should have validated the channels with one email channel, got: %d, %v", emailChannels, err)
+	}
+
+	emailWithFormat := notificationChannelConfig(notificationChannelTypeEmail, map[string]cty.Value{
+		"external_recipient_list": cty.ListVal([]cty.Value{cty.StringVal("a@b.com")}),
+		"format":                  cty.StringVal("JSON"),
+	})
+	_, err = validateNotificationCenterPolicyChannelsConfig(cty.ListVal([]cty.Value{webhook, emailWithFormat}))
+	if err == nil || err.Error() != "channel 1: format is not supported for channel type email" {
+		t.Errorf("Expected an error about the format of the email channel, got: %v", err)
+	}
+
+	webhookWithUnknownURL := notificationChannelConfig(notificationChannelTypeWebhook, map[string]cty.Value{
+		"url": cty.UnknownVal(cty.String),
+	})
+	if _, err := validateNotificationCenterPolicyChannelsConfig(cty.ListVal([]cty.Value{webhookWithUnknownURL})); err != nil {
+		t.Errorf("Should have assumed the unknown url is set, got: %s", err)
+	}
+}
+
+func TestSortNotificationChannelsLikeState(t *testing.T) {
+	email := map[string]interface{}{"type": notificationChannelTypeEmail}
+	webhookA := map[string]interface{}{"type": notificationChannelTypeWebhook, "url": "https://a.example.com"}
+	webhookB := map[string]interface{}{"type": notificationChannelTypeWebhook, "url": "https://b.example.com"}
+	webhookC := map[string]interface{}{"type": notificationChannelTypeWebhook, "url": "https://c.example.com"}
+
+	sorted := sortNotificationChannelsLikeState([]interface{}{email, webhookC, webhookA, webhookB}, []interface{}{webhookB, email, webhookA})
+	expected := []interface{}{webhookB, email, webhookA, webhookC}
+	if fmt.Sprint(sorted) != fmt.Sprint(expected) {
+		t.Errorf("Expected the channels in the state order, got: %v", sorted)
+	}
+}
+
+func TestGetNotificationChannelsFromResource(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceNotificationCenterPolicy().Schema, map[string]interface{}{
+		"account_id":                           1234,
+		"policy_name":                          "policy",
+		"sub_category":                         "ACCOUNT_NOTIFICATIONS",
+		"emailchannel_external_recipient_list": []interface{}{"john.mcclane@externalemail.com"},
+		"channel": []interface{}{
+			map[string]interface{}{
+				"type":             notificationChannelTypeWebhook,
+				"url":              "https://hooks.example.com/incapsula",
+				"auth_header_name": "Authorization",
+			},
+		},
+	})
+
+	channels := getNotificationChannelsFromResource(d)
+	if len(channels) != 2 {
+		t.Fatalf("Expected the email and webhook channels, got: %+v", channels)
+	}
+	if channels[0].ChannelType != notificationChannelTypeEmail || len(channels[0].RecipientToList) != 1 {
+		t.Errorf("Expected the email channel of the emailchannel_* arguments, got: %+v", channels[0])
+	}
+	if channels[1].ChannelType != notificationChannelTypeWebhook || channels[1].Format != "JSON" {
+		t.Errorf("Expected the webhook channel with the default JSON format, got: %+v", channels[1])
+	}
+}
+
+func TestValidateNotificationCenterPolicyAssets(t *testing.T) {
+	subCategories := []NotificationSubCategoryDto{
+		{SubCategory: "ACCOUNT_NOTIFICATIONS"},
+		{SubCategory: "SITE_NOTIFICATIONS", AssetTypes: []string{"SITE"}},
+		{SubCategory: "NETWORK_PROTECTION", AssetTypes: []string{"IP_RANGE"}},
+	}
+	cases := []struct {
+		subCategory      string
+		policyType       string
+		applyToNewAssets string
+		assetTypes       []string
+		expectedErr      string
+	}{
+		{"ACCOUNT_NOTIFICATIONS", "ACCOUNT", "FALSE", nil, ""},
+		{"ACCOUNT_NOTIFICATIONS", "ACCOUNT", "FALSE", []string{"SITE"}, "sub_category ACCOUNT_NOTIFICATIONS does not support assets"},
+		{"ACCOUNT_NOTIFICATIONS", "ACCOUNT", "TRUE", nil, "apply_to_new_assets is not supported for sub_category ACCOUNT_NOTIFICATIONS, which does not support assets"},
+		{"SITE_NOTIFICATIONS", "ACCOUNT", "FALSE", []string{"SITE"}, ""},
+		{"SITE_NOTIFICATIONS", "ACCOUNT", "FALSE", []string{"IP_RANGE"}, "asset_type IP_RANGE is not supported for sub_category SITE_NOTIFICATIONS, supported values are: SITE"},
+		{"SITE_NOTIFICATIONS", "ACCOUNT", "FALSE", nil, "at least one asset is required for sub_category SITE_NOTIFICATIONS when apply_to_new_assets is FALSE"},
+		{"SITE_NOTIFICATIONS", "ACCOUNT", "TRUE", nil, ""},
+		{"SITE_NOTIFICATIONS", "SUB_ACCOUNT", "FALSE", nil, ""},
+		{"NETWORK_PROTECTION", "ACCOUNT", "FALSE", []string{"IP_RANGE"}, ""},
+		{"SITE_NOTIFICATION", "ACCOUNT", "FALSE", nil, "unsupported sub_category SITE_NOTIFICATION, supported values are: ACCOUNT_NOTIFICATIONS, NETWORK_PROTECTION, SITE_NOTIFICATIONS"},
+	}
+
+	for _, c := range cases {
+		err := validateNotificationCenterPolicyAssets(subCategories, c.subCategory, c.policyType, c.applyToNewAssets, c.assetTypes)
+		if c.expectedErr == "" && err != nil {
+			t.Errorf("%+v: should not have received an error, got: %s", c, err)
+		}
+		if c.expectedErr != "" && (err == nil || err.Error() != c.expectedErr) {
+			t.Errorf("%+v: expected error %q, got: %v", c, c.expectedErr, err)
+		}
+	}
+}
+
+func TestValidateNotificationSubAccountTargeting(t *testing.T) {
+	cases := []struct {
+		mode        string
+		fields      map[string]interface{}
+		expectedErr string
+	}{
+		{"ALL", map[string]interface{}{}, ""},
+		{"ALL", map[string]interface{}{"ref_id_regex": "^acme-"}, "ref_id_regex is not supported for sub_account_targeting mode ALL"},
+		{"LIST", map[string]interface{}{"sub_account_ids": []int{1}}, ""},
+		{"LIST", map[string]interface{}{}, "sub_account_ids is required for sub_account_targeting mode LIST"},
+		{"REF_ID", map[string]interface{}{"ref_id_regex": "^acme-"}, ""},
+		{"REF_ID", map[string]interface{}{"ref_id_regex": "^acme-", "sub_account_ids": []int{1}}, "sub_account_ids is not supported for sub_account_targeting mode REF_ID"},
+		{"SOME", map[string]interface{}{}, "unsupported sub_account_targeting mode SOME"},
+	}
+
+	for _, c := range cases {
+		err := validateNotificationSubAccountTargeting(c.mode, func(key string) (interface{}, bool) {
+			value, ok := c.fields[key]
+			return value, ok
+		})
+		if c.expectedErr == "" && err != nil {
+			t.Errorf("%+v: should not have received an error, got: %s", c, err)
+		}
+		if c.expectedErr != "" && (err == nil || err.Error() != c.expectedErr) {
+			t.Errorf("%+v: expected error %q, got: %v", c, c.expectedErr, err)
+		}
+	}
+}
+
+func TestResolveNotificationSubAccountTargeting(t *testing.T) {
+	subAccounts := []SubAccount{
+		{SubAccountID: 3, SubAccountPayload: &SubAccountPayload{RefID: "acme-eu"}},
+		{SubAccountID: 1, SubAccountPayload: &SubAccountPayload{RefID: "acme-us"}},
+		{SubAccountID: 2, SubAccountPayload: &SubAccountPayload{RefID: "other"}},
+		{SubAccountID: 4},
+	}
+
+	cases := []struct {
+		mode                  string
+		subAccountIDs         []int
+		refIDRegex            string
+		expectedIDs           string
+		expectedApplyToNewSub string
+	}{
+		{"ALL", nil, "", "[1 2 3 4]", "TRUE"},
+		{"LIST", []int{7, 5}, "", "[5 7]", "FALSE"},
+		{"REF_ID", nil, "^acme-", "[1 3]", "FALSE"},
+		{"REF_ID", nil, "^none$", "[]", "FALSE"},
+	}
+
+	for _, c := range cases {
+		ids, applyToNewSubAccounts, err := resolveNotificationSubAccountTargeting(c.mode, c.subAccountIDs, c.refIDRegex, subAccounts)
+		if err != nil {
+			t.Errorf("%+v: should not have received an error, got: %s", c, err)
+		}
+		if fmt.Sprint(ids) != c.expectedIDs || applyToNewSubAccounts != c.expectedApplyToNewSub {
+			t.Errorf("%+v: expected %s %s, got: %v %s", c, c.expectedIDs, c.expectedApplyToNewSub, ids, applyToNewSubAccounts)
+		}
+	}
+
+	if _, _, err := resolveNotificationSubAccountTargeting("REF_ID", nil, "(", subAccounts); err == nil {
+		t.Errorf("Should have received an error for an invalid ref_id_regex")
+	}
+}
@@ -1,6 +1,7 @@
 package incapsula
 
 import (
+	"context"
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"log"
@@ -18,6 +19,21 @@ func resourceOriginPOP() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			if !d.HasChange("origin_pop") || !d.NewValueKnown("origin_pop") {
+				return nil
+			}
+
+			popsDTO, err := m.(*Client).GetPops()
+			if err != nil {
+				log.Printf("[WARN] Could not validate origin_pop: %s\n", err)
+				return nil
+			}
+			if err := validateOriginPop(popsDTO.Data, d.Get("origin_pop").(string)); err != nil {
+				return fmt.Errorf("origin_pop: %s", err)
+			}
+			return nil
+		},
 
 		Schema: map[string]*schema.Schema{
 			// Required Arguments
@@ -1,12 +1,15 @@
 package incapsula
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 // Security Rule Enumerations
@@ -31,6 +34,11 @@ const exceptionTypeClientAppType = "api.rule_exception_type.client_app_type"
 const exceptionTypeUserAgent = "api.rule_exception_type.user_agent"
 const exceptionTypeClientAppId = "api.rule_exception_type.client_app_id"
 
+// Exception fields of the resource, in the order they are documented
+var securityRuleExceptionParams = []string{"client_app_types", "client_apps", "countries", "continents", "ips", "urls", "user_agents", "parameters"}
+
+var securityRuleExceptionURLPatterns = []string{"CONTAINS", "EQUALS", "PREFIX", "SUFFIX", "NOT_EQUALS", "NOT_CONTAIN", "NOT_PREFIX", "NOT_SUFFIX"}
+
 // DeleteSecurityRuleExceptionResponse contains the response code for deleting a security exception
 type DeleteSecurityRuleExceptionResponse struct {
 	Res int `json:"res"`
@@ -62,6 +70,18 @@ func resourceSecurityRuleException() *schema.Resource {
 			},
 		},
 
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceSecurityRuleExceptionV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceSecurityRuleExceptionStateUpgradeV0,
+			},
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			return validateSecurityRuleExceptionDiff(d)
+		},
+
 		Schema: map[string]*schema.Schema{
 			// Required Arguments
 			"site_id": {
@@ -77,58 +97,67 @@ func resourceSecurityRuleException() *schema.Resource {
 				ForceNew:    true,
 			},
 			"client_app_types": {
-				Description:      "A comma separated list of client application types,",
-				Type:             schema.TypeString,
-				Optional:         true,
-				DiffSuppressFunc: suppressEquivalentStringDiffs,
+				Description: "A list of client application types.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"client_apps": {
-				Description:      "A comma separated list of client application IDs.",
-				Type:             schema.TypeString,
-				Optional:         true,
-				DiffSuppressFunc: suppressEquivalentStringDiffs,
+				Description: "A list of client application IDs.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"countries": {
-				Description:      "A comma separated list of country codes.",
-				Type:             schema.TypeString,
-				Optional:         true,
-				DiffSuppressFunc: suppressEquivalentStringDiffs,
+				Description: "A list of country codes.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"continents": {
-				Description:      "A comma separated list of continent codes.",
-				Type:             schema.TypeString,
-				Optional:         true,
-				DiffSuppressFunc: suppressEquivalentStringDiffs,
+				Description: "A list of continent codes.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"ips": {
-				Description:      "A comma separated list of IPs or IP ranges, e.g: 192.168.1.1, 192.168.1.1-192.168.1.100 or 192.168.1.1/24",
-				Type:             schema.TypeString,
-				Optional:         true,
-				DiffSuppressFunc: suppressEquivalentStringDiffs,
-			},
-			"url_patterns": {
-				Description:      "A comma separated list of url patterns. One of: contains | equals | prefix | suffix | not_equals | not_contain | not_prefix | not_suffix. The patterns should be in accordance with the matching urls sent by the urls parameter.",
-				Type:             schema.TypeString,
-				Optional:         true,
-				DiffSuppressFunc: suppressEquivalentStringDiffs,
+				Description: "A list of IPs or IP ranges, e.g: 192.168.1.1, 192.168.1.1-192.168.1.100 or 192.168.1.1/24",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"urls": {
-				Description:      "A comma separated list of resource paths. For example, /home and /admin/index.html are resource paths, while http://www.example.com/home is not. Each URL should be encoded separately using percent encoding as specified by RFC 3986 (http://tools.ietf.org/html/rfc3986#section-2.1). An empty URL list will remove all URLs.",
-				Type:             schema.TypeString,
-				Optional:         true,
-				DiffSuppressFunc: suppressEquivalentStringDiffs,
+				Description: "A list of resource paths and their matching patterns.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Description: "The resource path. For example, /home and /admin/index.html are resource paths, while http://www.example.com/home is not. Each URL should be encoded separately using percent encoding as specified by RFC 3986 (http://tools.ietf.org/html/rfc3986#section-2.1).",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"pattern": {
+							Description:  "The url pattern. One of: CONTAINS | EQUALS | PREFIX | SUFFIX | NOT_EQUALS | NOT_CONTAIN | NOT_PREFIX | NOT_SUFFIX.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "EQUALS",
+							ValidateFunc: validation.StringInSlice(securityRuleExceptionURLPatterns, false),
+						},
+					},
+				},
 			},
 			"user_agents": {
-				Description:      "A comma separated list of encoded user agents.",
-				Type:             schema.TypeString,
-				Optional:         true,
-				DiffSuppressFunc: suppressEquivalentStringDiffs,
+				Description: "A list of encoded user agents.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"parameters": {
-				Description:      "A comma separated list of encoded parameters.",
-				Type:             schema.TypeString,
-				Optional:         true,
-				DiffSuppressFunc: suppressEquivalentStringDiffs,
+				Description: "A list of encoded parameters.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"whitelist_id": {
 				Description: "The id (an integer) of the whitelist to be set. This field is optional - in case no id is supplied, a new whitelist will be created.",
@@ -144,6 +173,95 @@ func resourceSecurityRuleException() *schema.Resource {
 	}
 }
 
+// validateSecurityRuleExceptionDiff fails the plan when an exception field is not supported by the rule_id
+func validateSecurityRuleExceptionDiff(d *schema.ResourceDiff) error {
+	ruleID := d.Get("rule_id").(string)
+	if ruleID == "" {
+		// Not known until apply
+		return nil
+	}
+
+	var params []string
+	for _, param := range securityRuleExceptionParams {
+		if d.Get(param).(*schema.Set).Len() > 0 {
+			params = append(params, param)
+		}
+	}
+
+	return validateSecurityRuleExceptionParams(ruleID, params)
+}
+
+func expandSecurityRuleException(d *schema.ResourceData) *SecurityRuleException {
+	exception := SecurityRuleException{
+		ClientAppTypes: expandSecurityRuleExceptionStringSet(d.Get("client_app_types").(*schema.Set)),
+		ClientApps:     expandSecurityRuleExceptionStringSet(d.Get("client_apps").(*schema.Set)),
+		Countries:      expandSecurityRuleExceptionStringSet(d.Get("countries").(*schema.Set)),
+		Continents:     expandSecurityRuleExceptionStringSet(d.Get("continents").(*schema.Set)),
+		Ips:            expandSecurityRuleExceptionStringSet(d.Get("ips").(*schema.Set)),
+		UserAgents:     expandSecurityRuleExceptionStringSet(d.Get("user_agents").(*schema.Set)),
+		Parameters:     expandSecurityRuleExceptionStringSet(d.Get("parameters").(*schema.Set)),
+	}
+
+	for _, urlItem := range d.Get("urls").(*schema.Set).List() {
+		urlMap := urlItem.(map[string]interface{})
+		exception.Urls = append(exception.Urls, SecurityRuleExceptionURL{
+			URL:     urlMap["url"].(string),
+			Pattern: urlMap["pattern"].(string),
+		})
+	}
+
+	return &exception
+}
+
+func expandSecurityRuleExceptionStringSet(set *schema.Set) []string {
+	values := make([]string, 0, set.Len())
+	for _, value := range set.List() {
+		values = append(values, value.(string))
+	}
+	sort.Strings(values)
+	return values
+}
+
+// flattenSecurityRuleExceptionValues sets the exception fields from the structured site status exception values
+func flattenSecurityRuleExceptionValues(d *schema.ResourceData, values []SiteStatusRuleExceptionValue) {
+	fields := map[string][]string{}
+	var urls []interface{}
+
+	for _, value := range values {
+		switch value.ID {
+		case exceptionTypeUrl:
+			for _, exceptionURL := range value.Urls {
+				urls = append(urls, map[string]interface{}{
+					"url":     exceptionURL.Value,
+					"pattern": strings.ToUpper(exceptionURL.Pattern),
+				})
+			}
+		case exceptionTypeCountry:
+			fields["countries"] = value.Geo.Countries
+		case exceptionTypeContinent:
+			fields["continents"] = value.Geo.Continents
+		case exceptionTypeClientAppId:
+			fields["client_apps"] = value.ClientApps
+		case exceptionTypeClientAppType:
+			fields["client_app_types"] = value.ClientAppTypes
+		case exceptionTypeHttpParameter:
+			fields["parameters"] = value.Parameters
+		case exceptionTypeIp:
+			fields["ips"] = value.Ips
+		case exceptionTypeUserAgent:
+			fields["user_agents"] = value.UserAgents
+		}
+	}
+
+	for _, param := range securityRuleExceptionParams {
+		if param == "urls" {
+			d.Set("urls", urls)
+			continue
+		}
+		d.Set(param, fields[param])
+	}
+}
+
 func resourceSecurityRuleExceptionCreate(d *schema.ResourceData, m interface{}) error {
 	client := m.(*Client)
 
@@ -153,15 +271,7 @@ func resourceSecurityRuleExceptionCreate(d *schema.ResourceData, m interface{})
 	siteStatusResponse, err := client.AddSecurityRuleException(
 		d.Get("site_id").(int),
 		ruleID,
-		d.Get("client_app_types").(string),
-		d.Get("client_apps").(string),
-		d.Get("countries").(string),
-		d.Get("continents").(string),
-		d.Get("ips").(string),
-		d.Get("url_patterns").(string),
-		d.Get("urls").(string),
-		d.Get("user_agents").(string),
-		d.Get("parameters").(string),
+		expandSecurityRuleException(d),
 	)
 	if err != nil {
 		log.Printf("[ERROR] Could not create Incapsula security rule exception for rule_id (%s) on site_id (%d), %s\n", ruleID, d.Get("site_id").(int), err)
@@ -200,62 +310,29 @@ func resourceSecurityRuleExceptionRead(d *schema.ResourceData, m interface{}) er
 		return err
 	}
 
-	// Now with the site status, iterate through the rules and find our ID
-	exceptionFound := false
-	if ruleID == blacklistedCountriesExceptionRuleID || ruleID == blacklistedURLsExceptionRuleID || ruleID == blacklistedIPsExceptionRuleID {
-		for _, entry := range siteStatusResponse.Security.Acls.Rules {
-			if entry.ID == d.Get("rule_id").(string) {
-				for _, exception := range entry.Exceptions {
-					if exception.ID == whitelistID {
-						for _, value := range exception.Values {
-							d.Set(value.ID, value.Name)
-							exceptionFound = true
-							break
-						}
-					}
-				}
-			}
+	// Now with the site status, collect the exceptions of our rule (ACL or WAF)
+	var exceptions []SiteStatusRuleException
+	for _, entry := range siteStatusResponse.Security.Acls.Rules {
+		if entry.ID == ruleID {
+			exceptions = append(exceptions, entry.Exceptions...)
 		}
-	} else {
-		for _, entry := range siteStatusResponse.Security.Waf.Rules {
-			if entry.ID == d.Get("rule_id").(string) {
-				for _, exception := range entry.Exceptions {
-					if exception.ID == whitelistID {
-						for _, value := range exception.Values {
-							switch value.ID {
-							case exceptionTypeUrl:
-								var urlPatternList []string
-								var urlList []string
-								for _, url := range value.Urls {
-									urlList = append(urlList, url.Value)
-									urlPatternList = append(urlPatternList, url.Pattern)
-								}
-								d.Set("url_patterns", strings.Join(urlPatternList, ","))
-								d.Set("urls", strings.Join(urlList, ","))
-							case exceptionTypeCountry:
-								d.Set("countries", strings.Join(value.Geo.Countries, ","))
-							case exceptionTypeContinent:
-								d.Set("continents", strings.Join(value.Geo.Continents, ","))
-							case exceptionTypeClientAppId:
-								d.Set("client_apps", strings.Join(value.ClientApps, ","))
-							case exceptionTypeClientAppType:
-								d.Set("client_app_types", strings.Join(value.ClientAppTypes, ","))
-							case exceptionTypeHttpParameter:
-								d.Set("parameters", strings.Join(value.Parameters, ","))
-							case exceptionTypeIp:
-								d.Set("ips", strings.Join(value.Ips, ","))
-							case exceptionTypeUserAgent:
-								d.Set("user_agents", strings.Join(value.UserAgents, ","))
-
-							}
-						}
-						exceptionFound = true
-						break
-					}
-				}
-			}
+	}
+	for _, entry := range siteStatusResponse.Security.Waf.Rules {
+		if entry.ID == ruleID {
+			exceptions = append(exceptions, entry.Exceptions...)
 		}
 	}
+
+	// Find our exception ID
+	exceptionFound := false
+	for _, exception := range exceptions {
+		if exception.ID == whitelistID {
+			flattenSecurityRuleExceptionValues(d, exception.Values)
+			exceptionFound = true
+			break
+		}
+	}
+
 	if exceptionFound == false {
 		log.Printf("[ERROR] Read Incapsula security rule exception failed, exception not found: whitelist_id (%d) and rule_id (%s) on site_id (%d)\n", whitelistID, ruleID, d.Get("site_id").(int))
 		d.SetId("")
@@ -274,207 +351,20 @@ func resourceSecurityRuleExceptionUpdate(d *schema.ResourceData, m interface{})
 
 	log.Printf("[INFO] Updating Incapsula security rule exception for rule_id (%s) on site_id (%d)\n", ruleID, d.Get("site_id").(int))
 
-	// Add the appropriate exception params based on ruleID, set exception_id_only to return the whitelist_id for newly created rule
-	switch ruleID {
-	// ACL RuleIDs
-	case blacklistedCountriesExceptionRuleID:
-		_, err := client.EditSecurityRuleException(
-			d.Get("site_id").(int),
-			ruleID,
-			d.Get("client_app_types").(string),
-			"",
-			"",
-			"",
-			d.Get("ips").(string),
-			d.Get("url_patterns").(string),
-			d.Get("urls").(string),
-			"",
-			"",
-			whitelistID,
-		)
-		if err != nil {
-			log.Printf("[ERROR] Could not update Incapsula security rule exception for rule_id (%s) on site_id (%d), %s\n", ruleID, d.Get("site_id").(int), err)
-			return err
-		}
-	case blacklistedIPsExceptionRuleID:
-		_, err := client.EditSecurityRuleException(
-			d.Get("site_id").(int),
-			ruleID,
-			"",
-			d.Get("client_apps").(string),
-			d.Get("countries").(string),
-			d.Get("continents").(string),
-			d.Get("ips").(string),
-			d.Get("url_patterns").(string),
-			d.Get("urls").(string),
-			"",
-			"",
-			whitelistID,
-		)
-		if err != nil {
-			log.Printf("[ERROR] Could not update Incapsula security rule exception for rule_id (%s) on site_id (%d), %s\n", ruleID, d.Get("site_id").(int), err)
-			return err
-		}
-	case blacklistedURLsExceptionRuleID:
-		_, err := client.EditSecurityRuleException(
-			d.Get("site_id").(int),
-			ruleID,
-			"",
-			d.Get("client_apps").(string),
-			d.Get("countries").(string),
-			d.Get("continents").(string),
-			d.Get("ips").(string),
-			d.Get("url_patterns").(string),
-			d.Get("urls").(string),
-			"",
-			"",
-			whitelistID,
-		)
-		if err != nil {
-			log.Printf("[ERROR] Could not update Incapsula security rule exception for rule_id (%s) on site_id (%d), %s\n", ruleID, d.Get("site_id").(int), err)
-			return err
-		}
-	case backdoorExceptionRuleID:
-		_, err := client.EditSecurityRuleException(
-			d.Get("site_id").(int),
-			ruleID,
-			"",
-			d.Get("client_apps").(string),
-			d.Get("countries").(string),
-			d.Get("continents").(string),
-			d.Get("ips").(string),
-			d.Get("url_patterns").(string),
-			d.Get("urls").(string),
-			d.Get("user_agents").(string),
-			d.Get("parameters").(string),
-			whitelistID,
-		)
-		if err != nil {
-			log.Printf("[ERROR] Could not update Incapsula security rule exception for rule_id (%s) on site_id (%d), %s\n", ruleID, d.Get("site_id").(int), err)
-			return err
-		}
-	case botAccessControlExceptionRuleID:
-		_, err := client.EditSecurityRuleException(
-			d.Get("site_id").(int),
-			ruleID,
-			"",
-			"",
-			"",
-			"",
-			d.Get("ips").(string),
-			d.Get("url_patterns").(string),
-			d.Get("urls").(string),
-			d.Get("user_agents").(string),
-			"",
-			whitelistID,
-		)
-		if err != nil {
-			log.Printf("[ERROR] Could not update Incapsula security rule exception for rule_id (%s) on site_id (%d), %s\n", ruleID, d.Get("site_id").(int), err)
-			return err
-		}
-	case crossSiteScriptingExceptionRuleID:
-		_, err := client.EditSecurityRuleException(
-			d.Get("site_id").(int),
-			ruleID,
-			"",
-			d.Get("client_apps").(string),
-			d.Get("countries").(string),
-			d.Get("continents").(string),
-			"",
-			d.Get("url_patterns").(string),
-			d.Get("urls").(string),
-			"",
-			d.Get("parameters").(string),
-			whitelistID,
-		)
-		if err != nil {
-			log.Printf("[ERROR] Could not update Incapsula security rule exception for rule_id (%s) on site_id (%d), %s\n", ruleID, d.Get("site_id").(int), err)
-			return err
-		}
-	case ddosExceptionRuleID:
-		_, err := client.EditSecurityRuleException(
-			d.Get("site_id").(int),
-			ruleID,
-			"",
-			d.Get("client_apps").(string),
-			d.Get("countries").(string),
-			d.Get("continents").(string),
-			d.Get("ips").(string),
-			d.Get("url_patterns").(string),
-			d.Get("urls").(string),
-			"",
-			"",
-			whitelistID,
-		)
-		if err != nil {
-			log.Printf("[ERROR] Could not update Incapsula security rule exception for rule_id (%s) on site_id (%d), %s\n", ruleID, d.Get("site_id").(int), err)
-			return err
-		}
-	case illegalResourceAccessExceptionRuleID:
-		_, err := client.EditSecurityRuleException(
-			d.Get("site_id").(int),
-			ruleID,
-			"",
-			d.Get("client_apps").(string),
-			d.Get("countries").(string),
-			d.Get("continents").(string),
-			d.Get("ips").(string),
-			d.Get("url_patterns").(string),
-			d.Get("urls").(string),
-			"",
-			d.Get("parameters").(string),
-			whitelistID,
-		)
-		if err != nil {
-			log.Printf("[ERROR] Could not update Incapsula security rule exception for rule_id (%s) on site_id (%d), %s\n", ruleID, d.Get("site_id").(int), err)
-			return err
-		}
-	case remoteFileInclusionExceptionRuleID:
-		_, err := client.EditSecurityRuleException(
-			d.Get("site_id").(int),
-			ruleID,
-			"",
-			d.Get("client_apps").(string),
-			d.Get("countries").(string),
-			d.Get("continents").(string),
-			d.Get("ips").(string),
-			d.Get("url_patterns").(string),
-			d.Get("urls").(string),
-			d.Get("user_agents").(string),
-			d.Get("parameters").(string),
-			whitelistID,
-		)
-		if err != nil {
-			log.Printf("[ERROR] Could not update Incapsula security rule exception for rule_id (%s) on site_id (%d), %s\n", ruleID, d.Get("site_id").(int), err)
-			return err
-		}
-	case sqlInjectionExceptionRuleID:
-		_, err := client.EditSecurityRuleException(
-			d.Get("site_id").(int),
-			ruleID,
-			"",
-			d.Get("client_apps").(string),
-			d.Get("countries").(string),
-			d.Get("continents").(string),
-			d.Get("ips").(string),
-			d.Get("url_patterns").(string),
-			d.Get("urls").(string),
-			"",
-			d.Get("parameters").(string),
-			whitelistID,
-		)
-		if err != nil {
-			log.Printf("[ERROR] Could not update Incapsula security rule exception for rule_id (%s) on site_id (%d), %s\n", ruleID, d.Get("site_id").(int), err)
-			return err
-		}
+	_, err := client.EditSecurityRuleException(
+		d.Get("site_id").(int),
+		ruleID,
+		expandSecurityRuleException(d),
+		whitelistID,
+	)
+	if err != nil {
+		log.Printf("[ERROR] Could not update Incapsula security rule exception for rule_id (%s) on site_id (%d), %s\n", ruleID, d.Get("site_id").(int), err)
+		return err
 	}
 
-	// Set the rule ID as whitelistID
-	d.SetId(whitelistID)
-
 	log.Printf("[INFO] Updated Incapsula security rule exception for rule_id (%s) on site_id (%d)\n", ruleID, d.Get("site_id").(int))
 
-	return resourceWAFSecurityRuleRead(d, m)
+	return resourceSecurityRuleExceptionRead(d, m)
 }
 
 func resourceSecurityRuleExceptionDelete(d *schema.ResourceData, m interface{}) error {
@@ -501,3 +391,63 @@ func resourceSecurityRuleExceptionDelete(d *schema.ResourceData, m interface{})
 
 	return nil
 }
+
+// resourceSecurityRuleExceptionV0 is the schema of version 0, where the exception values were comma separated strings
+func resourceSecurityRuleExceptionV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"site_id":           {Type: schema.TypeInt, Required: true},
+			"rule_id":           {Type: schema.TypeString, Required: true},
+			"client_app_types":  {Type: schema.TypeString, Optional: true},
+			"client_apps":       {Type: schema.TypeString, Optional: true},
+			"countries":         {Type: schema.TypeString, Optional: true},
+			"continents":        {Type: schema.TypeString, Optional: true},
+			"ips":               {Type: schema.TypeString, Optional: true},
+			"url_patterns":      {Type: schema.TypeString, Optional: true},
+			"urls":              {Type: schema.TypeString, Optional: true},
+			"user_agents":       {Type: schema.TypeString, Optional: true},
+			"parameters":        {Type: schema.TypeString, Optional: true},
+			"whitelist_id":      {Type: schema.TypeString, Optional: true},
+			"exception_id_only": {Type: schema.TypeString, Optional: true},
+		},
+	}
+}
+
+// resourceSecurityRuleExceptionStateUpgradeV0 splits the comma separated exception values into lists,
+// and pairs the urls with their url_patterns
+func resourceSecurityRuleExceptionStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	splitValues := func(key string) []interface{} {
+		value, _ := rawState[key].(string)
+		var values []interface{}
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				values = append(values, item)
+			}
+		}
+		return values
+	}
+
+	urls := splitValues("urls")
+	urlPatterns := splitValues("url_patterns")
+	var urlObjects []interface{}
+	for i, exceptionURL := range urls {
+		pattern := "EQUALS"
+		if i < len(urlPatterns) {
+			pattern = strings.ToUpper(urlPatterns[i].(string))
+		}
+		urlObjects = append(urlObjects, map[string]interface{}{
+			"url":     exceptionURL,
+			"pattern": pattern,
+		})
+	}
+
+	for _, param := range securityRuleExceptionParams {
+		if param != "urls" {
+			rawState[param] = splitValues(param)
+		}
+	}
+	rawState["urls"] = urlObjects
+	delete(rawState, "url_patterns")
+
+	return rawState, nil
+}
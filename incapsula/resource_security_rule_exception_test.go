@@ -1,7 +1,9 @@
 package incapsula
 
 import (
+	"context"
 	"fmt"
+	"reflect"
 	"strconv"
 	"testing"
 
@@ -162,10 +164,16 @@ func testAccCheckACLSecurityRuleExceptionGoodConfigBlacklistedCountries(t *testi
 resource "incapsula_security_rule_exception" "example-waf-blacklisted-countries-rule-exception" {
   site_id = "${incapsula_site.example-site.id}"
   rule_id = "api.acl.blacklisted_countries"
-  client_app_types="DataScraper,"
-  ips="1.2.3.6,1.2.3.7"
-  url_patterns="EQUALS,CONTAINS"
-  urls="/myurl,/myurl2"
+  client_app_types = ["DataScraper"]
+  ips = ["1.2.3.6", "1.2.3.7"]
+  urls {
+    url = "/myurl"
+    pattern = "EQUALS"
+  }
+  urls {
+    url = "/myurl2"
+    pattern = "CONTAINS"
+  }
 }`, securityRuleExceptionResourceNameBlacklistedCountries,
 	)
 }
@@ -176,10 +184,16 @@ func testAccCheckACLSecurityRuleExceptionInvalidRuleIDBlacklistedCountries(t *te
 resource "incapsula_security_rule_exception" "example-waf-blacklisted-countries-rule-exception" {
   site_id = "${incapsula_site.example-site.id}"
   rule_id = "bad_rule_id"
-  client_app_types="DataScraper,"
-  ips="1.2.3.6,1.2.3.7"
-  url_patterns="EQUALS,CONTAINS"
-  urls="/myurl,/myurl2"
+  client_app_types = ["DataScraper"]
+  ips = ["1.2.3.6", "1.2.3.7"]
+  urls {
+    url = "/myurl"
+    pattern = "EQUALS"
+  }
+  urls {
+    url = "/myurl2"
+    pattern = "CONTAINS"
+  }
 }`, securityRuleExceptionResourceNameBlacklistedCountries,
 	)
 }
@@ -189,10 +203,52 @@ func testAccCheckACLSecurityRuleExceptionInvalidParamBlacklistedCountries(t *tes
 resource "incapsula_security_rule_exception" "example-waf-blacklisted-countries-rule-exception" {
   site_id = "${incapsula_site.example-site.id}"
   rule_id = "api.acl.blacklisted_countries"
-  client_app_types="DataScraper,"
-  ips="1.2.3.6,1.2.3."
-  url_patterns="EQUALS,CONTAINS"
-  urls="/myurl,myurl2"
+  client_app_types = ["DataScraper"]
+  ips = ["1.2.3.6", "1.2.3."]
+  urls {
+    url = "/myurl"
+    pattern = "EQUALS"
+  }
+  urls {
+    url = "myurl2"
+    pattern = "CONTAINS"
+  }
 }`, securityRuleExceptionResourceNameBlacklistedCountries,
 	)
 }
+
+func TestResourceSecurityRuleExceptionStateUpgradeV0(t *testing.T) {
+	rawState := map[string]interface{}{
+		"site_id":          1234,
+		"rule_id":          "api.threats.sql_injection",
+		"countries":        "FR, IL",
+		"ips":              "",
+		"urls":             "/admin,/login",
+		"url_patterns":     "prefix,EQUALS",
+		"client_app_types": "DataScraper,",
+	}
+
+	actual, err := resourceSecurityRuleExceptionStateUpgradeV0(context.Background(), rawState, nil)
+	if err != nil {
+		t.Fatalf("Should not have received an error, got: %s", err)
+	}
+
+	expected := map[string]interface{}{
+		"site_id":          1234,
+		"rule_id":          "api.threats.sql_injection",
+		"countries":        []interface{}{"FR", "IL"},
+		"ips":              []interface{}(nil),
+		"client_apps":      []interface{}(nil),
+		"continents":       []interface{}(nil),
+		"user_agents":      []interface{}(nil),
+		"parameters":       []interface{}(nil),
+		"client_app_types": []interface{}{"DataScraper"},
+		"urls": []interface{}{
+			map[string]interface{}{"url": "/admin", "pattern": "PREFIX"},
+			map[string]interface{}{"url": "/login", "pattern": "EQUALS"},
+		},
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Unexpected upgraded state\nexpected: %#v\nactual: %#v", expected, actual)
+	}
+}
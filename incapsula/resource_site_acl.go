@@ -0,0 +1,238 @@
+package incapsula
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceSiteACL() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSiteACLUpdate,
+		Read:   resourceSiteACLRead,
+		Update: resourceSiteACLUpdate,
+		Delete: resourceSiteACLDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				idSlice := strings.Split(d.Id(), "/")
+				if len(idSlice) != 2 || idSlice[0] == "" || idSlice[1] == "" {
+					return nil, fmt.Errorf("unexpected format of ID (%q), expected site_id/rule_id", d.Id())
+				}
+
+				siteID, err := strconv.Atoi(idSlice[0])
+				if err != nil {
+					return nil, err
+				}
+
+				d.Set("site_id", siteID)
+				d.Set("rule_id", idSlice[1])
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+		CustomizeDiff: func(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+			return validateSiteACLDiff(d)
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"site_id": {
+				Description: "Numeric identifier of the site to operate on.",
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"rule_id": {
+				Description:  "The identifier of the ACL rule. Possible values: api.acl.blacklisted_ips, api.acl.blacklisted_countries, api.acl.blacklisted_urls, api.acl.whitelisted_ips.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{blacklistedIPsExceptionRuleID, blacklistedCountriesExceptionRuleID, blacklistedURLsExceptionRuleID, whitelistedIPsACLRuleID}, false),
+			},
+
+			// Optional Arguments
+			"ips": {
+				Description: "A list of IPs or IP ranges, e.g: 192.168.1.1, 192.168.1.1-192.168.1.100 or 192.168.1.1/24. Used with api.acl.blacklisted_ips and api.acl.whitelisted_ips.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"countries": {
+				Description: "A list of country codes. Used with api.acl.blacklisted_countries.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"continents": {
+				Description: "A list of continent codes. Used with api.acl.blacklisted_countries.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"urls": {
+				Description: "A list of resource paths and their matching patterns. Used with api.acl.blacklisted_urls.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"url": {
+							Description: "The resource path, encoded using percent encoding as specified by RFC 3986.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"pattern": {
+							Description:  "The url pattern. One of: CONTAINS | EQUALS | PREFIX | SUFFIX | NOT_EQUALS | NOT_CONTAIN | NOT_PREFIX | NOT_SUFFIX.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "EQUALS",
+							ValidateFunc: validation.StringInSlice(securityRuleExceptionURLPatterns, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// validateSiteACLDiff fails the plan when a list is set which is not used by the rule_id
+func validateSiteACLDiff(d *schema.ResourceDiff) error {
+	ruleID := d.Get("rule_id").(string)
+	ruleParams, ok := siteACLParamMapping[ruleID]
+	if !ok {
+		// Unknown until apply, or already rejected by the rule_id validation
+		return nil
+	}
+
+	for _, param := range []string{"ips", "countries", "continents", "urls"} {
+		if d.Get(param).(*schema.Set).Len() == 0 {
+			continue
+		}
+
+		supported := false
+		for _, ruleParam := range ruleParams {
+			if param == ruleParam {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return fmt.Errorf("%s is not supported for rule_id (%s), supported values are: %s", param, ruleID, strings.Join(ruleParams, ", "))
+		}
+	}
+
+	return nil
+}
+
+func resourceSiteACLUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	siteID := d.Get("site_id").(int)
+	ruleID := d.Get("rule_id").(string)
+
+	acl := SiteACL{
+		Ips:        expandSecurityRuleExceptionStringSet(d.Get("ips").(*schema.Set)),
+		Countries:  expandSecurityRuleExceptionStringSet(d.Get("countries").(*schema.Set)),
+		Continents: expandSecurityRuleExceptionStringSet(d.Get("continents").(*schema.Set)),
+	}
+	for _, urlItem := range d.Get("urls").(*schema.Set).List() {
+		urlMap := urlItem.(map[string]interface{})
+		acl.Urls = append(acl.Urls, SecurityRuleExceptionURL{
+			URL:     urlMap["url"].(string),
+			Pattern: urlMap["pattern"].(string),
+		})
+	}
+
+	log.Printf("[INFO] Configuring Incapsula ACL rule_id (%s) on site_id (%d)\n", ruleID, siteID)
+
+	_, err := client.ConfigureSiteACL(siteID, ruleID, &acl)
+	if err != nil {
+		log.Printf("[ERROR] Could not configure Incapsula ACL rule_id (%s) on site_id (%d), %s\n", ruleID, siteID, err)
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%d/%s", siteID, ruleID))
+
+	log.Printf("[INFO] Configured Incapsula ACL rule_id (%s) on site_id (%d)\n", ruleID, siteID)
+
+	return resourceSiteACLRead(d, m)
+}
+
+func resourceSiteACLRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	siteID := d.Get("site_id").(int)
+	ruleID := d.Get("rule_id").(string)
+
+	log.Printf("[INFO] Reading Incapsula ACL rule_id (%s) on site_id (%d)\n", ruleID, siteID)
+
+	siteStatusResponse, err := client.SiteStatus("acl-read", siteID)
+
+	// Site object may have been deleted
+	if siteStatusResponse != nil && siteStatusResponse.Res.(float64) == 9413 {
+		log.Printf("[INFO] Incapsula Site ID %d has already been deleted: %s\n", siteID, err)
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] Could not read Incapsula ACL rule_id (%s) on site_id (%d), %s\n", ruleID, siteID, err)
+		return err
+	}
+
+	// An ACL rule without values is not listed in the site status
+	ips := []string{}
+	countries := []string{}
+	continents := []string{}
+	urls := []interface{}{}
+	for _, entry := range siteStatusResponse.Security.Acls.Rules {
+		if entry.ID != ruleID {
+			continue
+		}
+
+		ips = entry.Ips
+		countries = entry.Geo.Countries
+		continents = entry.Geo.Continents
+		for _, aclURL := range entry.Urls {
+			urls = append(urls, map[string]interface{}{
+				"url":     aclURL.Value,
+				"pattern": strings.ToUpper(aclURL.Pattern),
+			})
+		}
+		break
+	}
+
+	d.SetId(fmt.Sprintf("%d/%s", siteID, ruleID))
+	d.Set("ips", ips)
+	d.Set("countries", countries)
+	d.Set("continents", continents)
+	d.Set("urls", urls)
+
+	log.Printf("[INFO] Read Incapsula ACL rule_id (%s) on site_id (%d)\n", ruleID, siteID)
+
+	return nil
+}
+
+func resourceSiteACLDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	siteID := d.Get("site_id").(int)
+	ruleID := d.Get("rule_id").(string)
+
+	log.Printf("[INFO] Clearing Incapsula ACL rule_id (%s) on site_id (%d)\n", ruleID, siteID)
+
+	_, err := client.ConfigureSiteACL(siteID, ruleID, &SiteACL{})
+	if err != nil {
+		log.Printf("[ERROR] Could not clear Incapsula ACL rule_id (%s) on site_id (%d), %s\n", ruleID, siteID, err)
+		return err
+	}
+
+	// Set the ID to empty
+	// Implicitly clears the resource
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const siteACLResourceType = "incapsula_site_acl"
+const siteACLResourceName = "testacc-terraform-blacklisted-ips"
+const siteACLResource = siteACLResourceType + "." + siteACLResourceName
+
+func TestAccIncapsulaSiteACL_Basic(t *testing.T) {
+	log.Printf("========================BEGIN TEST========================")
+	log.Printf("[DEBUG]Running test resource_site_acl.TestAccIncapsulaSiteACL_Basic")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testACCStateSiteACLDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSiteACLBasic(t),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckSiteACLExists(siteACLResource),
+					resource.TestCheckResourceAttr(siteACLResource, "rule_id", blacklistedIPsExceptionRuleID),
+					resource.TestCheckResourceAttr(siteACLResource, "ips.#", "2"),
+				),
+			},
+			{
+				ResourceName:      siteACLResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testACCStateSiteACLDestroy(s *terraform.State) error {
+	client := testAccProvider.Meta().(*Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != siteACLResourceType {
+			continue
+		}
+
+		siteID, err := strconv.Atoi(rs.Primary.Attributes["site_id"])
+		if err != nil {
+			return fmt.Errorf("Error parsing site_id %v to int", rs.Primary.Attributes["site_id"])
+		}
+
+		siteStatusResponse, err := client.SiteStatus("acl-destroy", siteID)
+		if err != nil {
+			// The site is removed as well
+			continue
+		}
+
+		for _, entry := range siteStatusResponse.Security.Acls.Rules {
+			if entry.ID == rs.Primary.Attributes["rule_id"] && len(entry.Ips) > 0 {
+				return fmt.Errorf("Incapsula ACL rule_id (%s) for site_id (%d) still has values", entry.ID, siteID)
+			}
+		}
+	}
+
+	return nil
+}
+
+func testCheckSiteACLExists(name string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		res, ok := state.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Incapsula ACL resource not found: %s", name)
+		}
+
+		siteID, err := strconv.Atoi(res.Primary.Attributes["site_id"])
+		if err != nil {
+			return fmt.Errorf("Error parsing site_id %v to int", res.Primary.Attributes["site_id"])
+		}
+
+		client := testAccProvider.Meta().(*Client)
+		siteStatusResponse, err := client.SiteStatus("acl-exists", siteID)
+		if err != nil {
+			return fmt.Errorf("Incapsula site_id (%d) for ACL does not exist", siteID)
+		}
+
+		for _, entry := range siteStatusResponse.Security.Acls.Rules {
+			if entry.ID == res.Primary.Attributes["rule_id"] && len(entry.Ips) > 0 {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("Incapsula ACL rule_id (%s) for site_id (%d) has no values", res.Primary.Attributes["rule_id"], siteID)
+	}
+}
+
+func testAccCheckSiteACLBasic(t *testing.T) string {
+	return testAccCheckIncapsulaSiteConfigBasic(GenerateTestDomain(t)) + fmt.Sprintf(`
+	resource "incapsula_site_acl" "%s" {
+		site_id = incapsula_site.testacc-terraform-site.id
+		rule_id = "api.acl.blacklisted_ips"
+		ips     = ["192.168.1.1", "192.168.1.2-192.168.1.10"]
+		depends_on = ["%s"]
+	}`,
+		siteACLResourceName, siteResourceName,
+	)
+}
@@ -0,0 +1,260 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// A list of HTTP status codes and ranges, e.g. 500,502-599
+var siteMonitoringHttpResponseErrorRegex = regexp.MustCompile(`^[1-5][0-9]{2}(-[1-5][0-9]{2})?(,[1-5][0-9]{2}(-[1-5][0-9]{2})?)*$`)
+
+func resourceSiteMonitoring() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceSiteMonitoringUpdate,
+		Read:   resourceSiteMonitoringRead,
+		Update: resourceSiteMonitoringUpdate,
+		Delete: resourceSiteMonitoringDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				d.Set("site_id", d.Id())
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"site_id": {
+				Description: "Numeric identifier of the site to operate on.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			// Optional Arguments
+			"failed_requests_percentage": {
+				Description:  "The percentage of failed requests to the origin server, above which the server is considered down.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      siteMonitoringDefaults.FailedRequestsPercentage,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"failed_requests_min_number": {
+				Description:  "The minimum number of failed requests to the origin server, before the server is considered down.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      siteMonitoringDefaults.FailedRequestsMinNumber,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"failed_requests_duration": {
+				Description:  "The duration of the period the failed requests are counted in.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      siteMonitoringDefaults.FailedRequestsDuration,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"failed_requests_duration_units": {
+				Description:  "The units of failed_requests_duration: SECONDS or MINUTES.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      siteMonitoringDefaults.FailedRequestsDurationUnits,
+				ValidateFunc: validation.StringInSlice([]string{"SECONDS", "MINUTES"}, false),
+			},
+			"http_request_timeout": {
+				Description:  "The maximum time to wait for an HTTP response from the origin server, before the request is considered failed.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      siteMonitoringDefaults.HttpRequestTimeout,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"http_request_timeout_units": {
+				Description:  "The units of http_request_timeout: MILLISECONDS or SECONDS.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      siteMonitoringDefaults.HttpRequestTimeoutUnits,
+				ValidateFunc: validation.StringInSlice([]string{"MILLISECONDS", "SECONDS"}, false),
+			},
+			"http_response_error": {
+				Description:  "The HTTP response codes considered as failed requests, as a comma separated list of codes and ranges, e.g. 500,502-599.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      siteMonitoringDefaults.HttpResponseError,
+				ValidateFunc: validation.StringMatch(siteMonitoringHttpResponseErrorRegex, "must be a comma separated list of HTTP status codes and ranges, e.g. 500,502-599"),
+			},
+			"use_up_checks": {
+				Description: "Check that a server considered down is up again, by sending requests to up_checks_url.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     siteMonitoringDefaults.UseUpChecks,
+			},
+			"up_checks_url": {
+				Description: "The URL the up checks requests are sent to.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     siteMonitoringDefaults.UpChecksUrl,
+			},
+			"up_checks_expected_received_string": {
+				Description: "A string the up checks response must contain for the server to be considered up. Any response is accepted when empty.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     siteMonitoringDefaults.UpChecksExpectedReceivedString,
+			},
+			"up_check_retries": {
+				Description:  "The number of failed up checks before the server is considered down.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      siteMonitoringDefaults.UpCheckRetries,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"up_checks_interval": {
+				Description:  "The interval between up checks.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      siteMonitoringDefaults.UpChecksInterval,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"up_checks_interval_units": {
+				Description:  "The units of up_checks_interval: SECONDS or MINUTES.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      siteMonitoringDefaults.UpChecksIntervalUnits,
+				ValidateFunc: validation.StringInSlice([]string{"SECONDS", "MINUTES"}, false),
+			},
+			"alarm_on_stands_by_failover": {
+				Description: "Send an alarm when failing over to a standby data center.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     siteMonitoringDefaults.AlarmOnStandsByFailover,
+			},
+			"alarm_on_dc_failover": {
+				Description: "Send an alarm when a data center fails over.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     siteMonitoringDefaults.AlarmOnDcFailover,
+			},
+			"alarm_on_server_failover": {
+				Description: "Send an alarm when an origin server fails over.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     siteMonitoringDefaults.AlarmOnServerFailover,
+			},
+			"alarm_recipients": {
+				Description: "Email addresses to send the alarms to.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func populateFromConfSiteMonitoringDTO(d *schema.ResourceData) SiteMonitoringDTO {
+	alarmRecipients := []string{}
+	for _, recipient := range d.Get("alarm_recipients").(*schema.Set).List() {
+		alarmRecipients = append(alarmRecipients, recipient.(string))
+	}
+
+	return SiteMonitoringDTO{
+		Data: []SiteMonitoringStruct{{
+			FailedRequestsPercentage:       d.Get("failed_requests_percentage").(int),
+			FailedRequestsMinNumber:        d.Get("failed_requests_min_number").(int),
+			FailedRequestsDuration:         d.Get("failed_requests_duration").(int),
+			FailedRequestsDurationUnits:    d.Get("failed_requests_duration_units").(string),
+			HttpRequestTimeout:             d.Get("http_request_timeout").(int),
+			HttpRequestTimeoutUnits:        d.Get("http_request_timeout_units").(string),
+			HttpResponseError:              d.Get("http_response_error").(string),
+			UseUpChecks:                    d.Get("use_up_checks").(bool),
+			UpChecksUrl:                    d.Get("up_checks_url").(string),
+			UpChecksExpectedReceivedString: d.Get("up_checks_expected_received_string").(string),
+			UpCheckRetries:                 d.Get("up_check_retries").(int),
+			UpChecksInterval:               d.Get("up_checks_interval").(int),
+			UpChecksIntervalUnits:          d.Get("up_checks_interval_units").(string),
+			AlarmOnStandsByFailover:        d.Get("alarm_on_stands_by_failover").(bool),
+			AlarmOnDcFailover:              d.Get("alarm_on_dc_failover").(bool),
+			AlarmOnServerFailover:          d.Get("alarm_on_server_failover").(bool),
+			AlarmRecipients:                alarmRecipients,
+		}},
+	}
+}
+
+func resourceSiteMonitoringUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	requestDTO := populateFromConfSiteMonitoringDTO(d)
+	responseDTO, err := client.PutSiteMonitoring(d.Get("site_id").(string), requestDTO)
+	if err != nil {
+		return fmt.Errorf("Error updating site monitoring for site (%s): %s", d.Get("site_id"), err)
+	}
+
+	if len(responseDTO.Errors) > 0 {
+		return fmt.Errorf("Error updating site monitoring for site (%s): %s", d.Get("site_id"), formatApiErrors(responseDTO.Errors))
+	}
+
+	d.SetId(d.Get("site_id").(string))
+
+	return resourceSiteMonitoringRead(d, m)
+}
+
+func resourceSiteMonitoringRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	responseDTO, err := client.GetSiteMonitoring(d.Get("site_id").(string))
+	if err != nil {
+		return fmt.Errorf("Error getting site monitoring for site (%s): %s", d.Get("site_id"), err)
+	}
+
+	if len(responseDTO.Errors) > 0 {
+		if responseDTO.Errors[0].Status == "404" {
+			log.Printf("[INFO] Incapsula Site ID %s has already been deleted: %s\n", d.Get("site_id"), responseDTO.Errors)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("Error getting site monitoring for site (%s): %s", d.Get("site_id"), formatApiErrors(responseDTO.Errors))
+	}
+
+	if len(responseDTO.Data) == 0 {
+		return fmt.Errorf("Error getting site monitoring for site (%s): empty response", d.Get("site_id"))
+	}
+
+	monitoring := responseDTO.Data[0]
+	d.Set("failed_requests_percentage", monitoring.FailedRequestsPercentage)
+	d.Set("failed_requests_min_number", monitoring.FailedRequestsMinNumber)
+	d.Set("failed_requests_duration", monitoring.FailedRequestsDuration)
+	d.Set("failed_requests_duration_units", monitoring.FailedRequestsDurationUnits)
+	d.Set("http_request_timeout", monitoring.HttpRequestTimeout)
+	d.Set("http_request_timeout_units", monitoring.HttpRequestTimeoutUnits)
+	d.Set("http_response_error", monitoring.HttpResponseError)
+	d.Set("use_up_checks", monitoring.UseUpChecks)
+	d.Set("up_checks_url", monitoring.UpChecksUrl)
+	d.Set("up_checks_expected_received_string", monitoring.UpChecksExpectedReceivedString)
+	d.Set("up_check_retries", monitoring.UpCheckRetries)
+	d.Set("up_checks_interval", monitoring.UpChecksInterval)
+	d.Set("up_checks_interval_units", monitoring.UpChecksIntervalUnits)
+	d.Set("alarm_on_stands_by_failover", monitoring.AlarmOnStandsByFailover)
+	d.Set("alarm_on_dc_failover", monitoring.AlarmOnDcFailover)
+	d.Set("alarm_on_server_failover", monitoring.AlarmOnServerFailover)
+	d.Set("alarm_recipients", monitoring.AlarmRecipients)
+
+	return nil
+}
+
+// resourceSiteMonitoringDelete restores the default monitoring settings of the site
+func resourceSiteMonitoringDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	responseDTO, err := client.PutSiteMonitoring(d.Get("site_id").(string), SiteMonitoringDTO{Data: []SiteMonitoringStruct{siteMonitoringDefaults}})
+	if err != nil {
+		return fmt.Errorf("Error deleting site monitoring for site (%s): %s", d.Get("site_id"), err)
+	}
+
+	if len(responseDTO.Errors) > 0 && responseDTO.Errors[0].Status != "404" {
+		return fmt.Errorf("Error deleting site monitoring for site (%s): %s", d.Get("site_id"), formatApiErrors(responseDTO.Errors))
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,75 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+const siteMonitoringResource = "incapsula_site_monitoring"
+const siteMonitoringName = "testacc-terraform-site-monitoring"
+const siteMonitoringResourceName = siteMonitoringResource + "." + siteMonitoringName
+
+func TestAccIncapsulaSiteMonitoring_Basic(t *testing.T) {
+	log.Printf("======================== BEGIN TEST ========================")
+	log.Printf("[DEBUG] Running test resource_site_monitoring_test.TestAccIncapsulaSiteMonitoring_Basic")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIncapsulaSiteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIncapsulaSiteMonitoringBasic(t),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaSiteMonitoringExists(siteMonitoringResourceName),
+					resource.TestCheckResourceAttr(siteMonitoringResourceName, "failed_requests_percentage", "30"),
+					resource.TestCheckResourceAttr(siteMonitoringResourceName, "up_checks_url", "/health"),
+					resource.TestCheckResourceAttr(siteMonitoringResourceName, "alarm_recipients.#", "1"),
+				),
+			},
+			{
+				ResourceName:      siteMonitoringResourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testCheckIncapsulaSiteMonitoringExists(name string) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		res, ok := state.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Incapsula site monitoring resource not found: %s", name)
+		}
+
+		siteID := res.Primary.ID
+		if siteID == "" {
+			return fmt.Errorf("Incapsula site monitoring ID does not exist")
+		}
+
+		client := testAccProvider.Meta().(*Client)
+		responseDTO, err := client.GetSiteMonitoring(siteID)
+		if err != nil || responseDTO == nil || len(responseDTO.Data) == 0 {
+			return fmt.Errorf("Incapsula site monitoring for site ID %s does not exist: %v", siteID, err)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckIncapsulaSiteMonitoringBasic(t *testing.T) string {
+	return testAccCheckIncapsulaSiteConfigBasic(GenerateTestDomain(t)) + fmt.Sprintf(`
+resource "%s" "%s" {
+  site_id = %s.id
+  failed_requests_percentage = 30
+  up_checks_url = "/health"
+  up_checks_interval = 30
+  alarm_recipients = ["noc@example.com"]
+  depends_on = ["%s"]
+}`, siteMonitoringResource, siteMonitoringName, siteResourceName, siteResourceName,
+	)
+}
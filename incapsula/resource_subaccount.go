@@ -1,6 +1,7 @@
 package incapsula
 
 import (
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"log"
@@ -12,6 +13,7 @@ func resourceSubAccount() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceSubAccountCreate,
 		Read:   resourceSubAccountRead,
+		Update: resourceSubAccountUpdate,
 		Delete: resourceSubAccountDelete,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
@@ -20,14 +22,13 @@ func resourceSubAccount() *schema.Resource {
 		Schema: map[string]*schema.Schema{
 			// Required Arguments
 			"sub_account_name": {
-				Description: "The name of the new sub-account.",
+				Description: "The name of the sub-account.",
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
 			},
 			// Optional Arguments
 			"parent_id": {
-				Description: "The newly created account's parent id. If not specified, the invoking account will be assigned as the parent.",
+				Description: "The newly created account's parent id. If not specified, the invoking account will be assigned as the parent. Changing it creates a new sub-account.",
 				Type:        schema.TypeInt,
 				Optional:    true,
 				Computed:    true,
@@ -37,19 +38,16 @@ func resourceSubAccount() *schema.Resource {
 				Description: "Customer specific identifier for this operation.",
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
 			},
 			"logs_account_id": {
 				Description: "Available only for Enterprise Plan customers that purchased the Logs Integration SKU. Numeric identifier of the account that purchased the logs integration SKU and which collects the logs. If not specified, operation will be performed on the account identified by the authentication parameters.",
 				Type:        schema.TypeInt,
 				Optional:    true,
-				ForceNew:    true,
 			},
 			"log_level": {
 				Description:  "The log level. Options are `full`, `security`, `none` and `default`.",
 				Type:         schema.TypeString,
 				Optional:     true,
-				ForceNew:     true,
 				ValidateFunc: validation.StringInSlice([]string{"full", "security", "none", "default"}, false),
 			},
 		},
@@ -94,7 +92,7 @@ func resourceSubAccountCreate(d *schema.ResourceData, m interface{}) error {
 func resourceSubAccountRead(d *schema.ResourceData, m interface{}) error {
 	client := m.(*Client)
 	subAccountID, _ := strconv.Atoi(d.Id())
-	subAccount, err := client.GetSubAccount(d.Get("parent_id").(int), subAccountID)
+	subAccount, err := client.GetSubAccountByID(subAccountID)
 
 	if err != nil {
 		return err
@@ -109,7 +107,10 @@ func resourceSubAccountRead(d *schema.ResourceData, m interface{}) error {
 	d.Set("sub_account_name", subAccount.SubAccountName)
 	d.Set("ref_id", subAccount.RefID)
 	d.Set("log_level", subAccount.LogLevel)
-	d.Set("parent_id", subAccount.ParentID)
+	// Changing the parent id replaces the sub-account, keep the state value when it's not returned
+	if subAccount.ParentID != 0 {
+		d.Set("parent_id", subAccount.ParentID)
+	}
 	d.Set("logs_account_id", subAccount.LogsAccountID)
 
 	log.Printf("[INFO] Finished reading Incapsula subaccount: %s\n", d.Id())
@@ -117,6 +118,31 @@ func resourceSubAccountRead(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+func resourceSubAccountUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+	subAccountID, _ := strconv.Atoi(d.Id())
+
+	updateParams := []string{"sub_account_name", "ref_id", "log_level", "logs_account_id"}
+	for _, param := range updateParams {
+		if !d.HasChange(param) {
+			continue
+		}
+
+		value := fmt.Sprint(d.Get(param))
+		if param == "logs_account_id" && d.Get(param).(int) == 0 {
+			value = ""
+		}
+		log.Printf("[INFO] Updating Incapsula subaccount param (%s) with value (%s) for subaccount id: %d\n", param, value, subAccountID)
+		err := client.UpdateSubAccount(subAccountID, param, value)
+		if err != nil {
+			log.Printf("[ERROR] Could not update Incapsula subaccount param (%s) with value (%s) for subaccount id: %d, %s\n", param, value, subAccountID, err)
+			return err
+		}
+	}
+
+	return resourceSubAccountRead(d, m)
+}
+
 func resourceSubAccountDelete(d *schema.ResourceData, m interface{}) error {
 	client := m.(*Client)
 	subAccountID, _ := strconv.Atoi(d.Id())
@@ -29,6 +29,14 @@ func TestAccIncapsulaSubAccount_Basic(t *testing.T) {
 					resource.TestCheckResourceAttr(subAccountResourceType+"."+subAccountResourceName, "sub_account_name", subAccountName),
 				),
 			},
+			{
+				Config: getAccIncapsulaSubAccountConfigRenamed(),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckIncapsulaSubAccountExists(),
+					resource.TestCheckResourceAttr(subAccountResourceType+"."+subAccountResourceName, "sub_account_name", subAccountName+"-renamed"),
+					resource.TestCheckResourceAttr(subAccountResourceType+"."+subAccountResourceName, "ref_id", "renamed-ref"),
+				),
+			},
 			{
 				ResourceName:      subAccountResourceType + "." + subAccountResourceName,
 				ImportState:       true,
@@ -48,6 +56,16 @@ func getAccIncapsulaSubAccountConfigBasic() string {
 	)
 }
 
+func getAccIncapsulaSubAccountConfigRenamed() string {
+	return fmt.Sprintf(`
+		resource "%s" "%s" {
+			sub_account_name = "%s-renamed"
+			ref_id = "renamed-ref"
+		}`,
+		subAccountResourceType, subAccountResourceName, subAccountName,
+	)
+}
+
 func testAccIncapsulaSubAccountDestroy(state *terraform.State) error {
 	client := testAccProvider.Meta().(*Client)
 
@@ -59,7 +77,7 @@ func testAccIncapsulaSubAccountDestroy(state *terraform.State) error {
 		subAccountIDStr := res.Primary.ID
 		subAccountID, _ := strconv.Atoi(subAccountIDStr)
 
-		subAccount, err := client.GetSubAccount(0, subAccountID)
+		subAccount, err := client.GetSubAccountByID(subAccountID)
 		if err != nil {
 			return err
 		}
@@ -116,7 +134,7 @@ func testCheckIncapsulaSubAccountExists() resource.TestCheckFunc {
 
 		client := testAccProvider.Meta().(*Client)
 		log.Printf("[INFO] **** subAccountID: %d", subAccountID)
-		subAccount, err := client.GetSubAccount(0, subAccountID)
+		subAccount, err := client.GetSubAccountByID(subAccountID)
 		if err != nil {
 			return err
 		}
@@ -0,0 +1,238 @@
+package incapsula
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// wafRulesPolicyActionAttributes maps the resource attributes to the WAF Rules policy setting types
+var wafRulesPolicyActionAttributes = map[string]string{
+	"sql_injection_action":           wafRulesSettingTypeSQLInjection,
+	"cross_site_scripting_action":    wafRulesSettingTypeCrossSiteScripting,
+	"illegal_resource_access_action": wafRulesSettingTypeIllegalResourceAccess,
+	"remote_file_inclusion_action":   wafRulesSettingTypeRemoteFileInclusion,
+}
+
+var wafRulesPolicyActions = []string{wafRulesActionBlock, wafRulesActionBlockUser, wafRulesActionBlockIP, wafRulesActionAlert, wafRulesActionIgnore}
+
+func resourceWAFRulesPolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceWAFRulesPolicyCreate,
+		Read:   resourceWAFRulesPolicyRead,
+		Update: resourceWAFRulesPolicyUpdate,
+		Delete: resourceWAFRulesPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			// Required Arguments
+			"name": {
+				Description: "The policy name.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+
+			// Optional Arguments
+			"enabled": {
+				Description: "Enables the policy.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"account_id": {
+				Description: "The Account ID of the policy.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"description": {
+				Description: "The policy description.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"sql_injection_action": {
+				Description:  "The action taken when an SQL injection threat is detected. Possible values: BLOCK, BLOCK_USER, BLOCK_IP, ALERT, IGNORE. Defaults to BLOCK, or to the legacy action of migrate_from_site_id.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(wafRulesPolicyActions, false),
+			},
+			"cross_site_scripting_action": {
+				Description:  "The action taken when a cross site scripting threat is detected. Possible values: BLOCK, BLOCK_USER, BLOCK_IP, ALERT, IGNORE. Defaults to BLOCK, or to the legacy action of migrate_from_site_id.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(wafRulesPolicyActions, false),
+			},
+			"illegal_resource_access_action": {
+				Description:  "The action taken when an illegal resource access threat is detected. Possible values: BLOCK, BLOCK_USER, BLOCK_IP, ALERT, IGNORE. Defaults to BLOCK, or to the legacy action of migrate_from_site_id.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(wafRulesPolicyActions, false),
+			},
+			"remote_file_inclusion_action": {
+				Description:  "The action taken when a remote file inclusion threat is detected. Possible values: BLOCK, BLOCK_USER, BLOCK_IP, ALERT, IGNORE. Defaults to BLOCK, or to the legacy action of migrate_from_site_id.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(wafRulesPolicyActions, false),
+			},
+			"migrate_from_site_id": {
+				Description: "Numeric identifier of a site to migrate from the legacy WAF settings. The policy is created with the legacy actions of the site for the actions which are not configured, and is applied to the site.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func resourceWAFRulesPolicyCreate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+	siteID := d.Get("migrate_from_site_id").(int)
+
+	// The actions which are not configured are BLOCK, or the legacy actions of the migrated site
+	actions := map[string]string{}
+	for _, settingType := range wafRulesPolicyActionAttributes {
+		actions[settingType] = wafRulesActionBlock
+	}
+	if siteID != 0 {
+		siteStatusResponse, policySettings, err := client.GetWAFRulesPolicySettingsFromSite(siteID)
+		if err != nil {
+			log.Printf("[ERROR] Could not read Incapsula WAF rules of site id (%d) for migration: %s\n", siteID, err)
+			return err
+		}
+		for _, policySetting := range policySettings {
+			actions[policySetting.PolicySettingType] = policySetting.SettingsAction
+		}
+		if !isWAFRulesPolicyAttributeConfigured(d, "account_id") {
+			d.Set("account_id", siteStatusResponse.AccountID)
+		}
+	}
+	for attribute, settingType := range wafRulesPolicyActionAttributes {
+		if !isWAFRulesPolicyAttributeConfigured(d, attribute) {
+			d.Set(attribute, actions[settingType])
+		}
+	}
+
+	policySubmitted := getWAFRulesPolicySubmitted(d)
+
+	policyAddResponse, err := client.AddPolicy(&policySubmitted)
+	if err != nil {
+		log.Printf("[ERROR] Could not create Incapsula WAF Rules policy: %s - %s\n", policySubmitted.Name, err)
+		return err
+	}
+
+	// Set the policyID
+	policyID := strconv.Itoa(policyAddResponse.Value.ID)
+	d.SetId(policyID)
+	log.Printf("[INFO] Created Incapsula WAF Rules policy with ID: %s\n", policyID)
+
+	if siteID != 0 {
+		err = client.AddPolicyAssetAssociation(policyID, strconv.Itoa(siteID), "WEBSITE")
+		if err != nil {
+			log.Printf("[ERROR] Could not apply Incapsula WAF Rules policy %s to site id (%d): %s\n", policyID, siteID, err)
+			return err
+		}
+		log.Printf("[INFO] Applied Incapsula WAF Rules policy %s to site id (%d)\n", policyID, siteID)
+	}
+
+	return resourceWAFRulesPolicyRead(d, m)
+}
+
+// isWAFRulesPolicyAttributeConfigured returns whether an attribute is in the configuration
+func isWAFRulesPolicyAttributeConfigured(d *schema.ResourceData, attribute string) bool {
+	rawConfig := d.GetRawConfig()
+	return !rawConfig.IsNull() && !rawConfig.GetAttr(attribute).IsNull()
+}
+
+func resourceWAFRulesPolicyRead(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	policyID := d.Id()
+	policyGetResponse, err := client.GetPolicy(policyID)
+	if err != nil {
+		log.Printf("[ERROR] Could not get Incapsula WAF Rules policy: %s - %s\n", policyID, err)
+		return err
+	}
+
+	if policyGetResponse.Value.PolicyType != wafRulesPolicyType {
+		return fmt.Errorf("Incapsula policy %s is of type %s, expected %s", policyID, policyGetResponse.Value.PolicyType, wafRulesPolicyType)
+	}
+
+	d.Set("name", policyGetResponse.Value.Name)
+	d.Set("enabled", policyGetResponse.Value.Enabled)
+	d.Set("account_id", policyGetResponse.Value.AccountID)
+	d.Set("description", policyGetResponse.Value.Description)
+
+	for attribute, settingType := range wafRulesPolicyActionAttributes {
+		for _, policySetting := range policyGetResponse.Value.PolicySettings {
+			if policySetting.PolicySettingType == settingType {
+				d.Set(attribute, policySetting.SettingsAction)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+func resourceWAFRulesPolicyUpdate(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	id, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return err
+	}
+
+	policySubmitted := getWAFRulesPolicySubmitted(d)
+
+	_, err = client.UpdatePolicy(id, &policySubmitted)
+	if err != nil {
+		log.Printf("[ERROR] Could not update Incapsula WAF Rules policy: %s - %s\n", d.Id(), err)
+		return err
+	}
+
+	return resourceWAFRulesPolicyRead(d, m)
+}
+
+func resourceWAFRulesPolicyDelete(d *schema.ResourceData, m interface{}) error {
+	client := m.(*Client)
+
+	err := client.DeletePolicy(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// Set the ID to empty
+	// Implicitly clears the resource
+	d.SetId("")
+
+	return nil
+}
+
+func getWAFRulesPolicySubmitted(d *schema.ResourceData) PolicySubmitted {
+	policySettings := make([]PolicySetting, 0, len(wafRulesPolicyActionAttributes))
+	for _, attribute := range []string{"sql_injection_action", "cross_site_scripting_action", "illegal_resource_access_action", "remote_file_inclusion_action"} {
+		policySettings = append(policySettings, PolicySetting{
+			PolicySettingType: wafRulesPolicyActionAttributes[attribute],
+			SettingsAction:    d.Get(attribute).(string),
+		})
+	}
+
+	return PolicySubmitted{
+		Name:           d.Get("name").(string),
+		Enabled:        d.Get("enabled").(bool),
+		PolicyType:     wafRulesPolicyType,
+		AccountID:      d.Get("account_id").(int),
+		Description:    d.Get("description").(string),
+		PolicySettings: policySettings,
+	}
+}
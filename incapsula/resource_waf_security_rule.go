@@ -66,6 +66,7 @@ func resourceWAFSecurityRule() *schema.Resource {
 				Description: "The action that should be taken when a threat is detected, for example: api.threats.action.block_ip.",
 				Type:        schema.TypeString,
 				Optional:    true,
+				Deprecated:  "Use resource incapsula_waf_rules_policy to manage the SQL injection, cross site scripting, illegal resource access and remote file inclusion actions.",
 			},
 
 			// Required for rule_id: api.threats.ddos